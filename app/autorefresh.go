@@ -0,0 +1,100 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// refreshIndicatorDuration is how long [↺] stays in the status bar
+const refreshIndicatorDuration = time.Second
+
+// defaultRefreshIntervals maps pane IDs to their auto-refresh interval
+var defaultRefreshIntervals = map[string]time.Duration{
+	"workspace": 5 * time.Second,
+	"packages":  60 * time.Second,
+}
+
+// autoRefreshMsg triggers the periodic refresh of one pane
+type autoRefreshMsg struct {
+	paneID string
+}
+
+// refreshRequestMsg fires when a debounced refresh delay has passed
+type refreshRequestMsg struct {
+	paneID string
+	token  int64
+}
+
+// refreshIndicatorMsg re-renders once the refresh indicator expires
+type refreshIndicatorMsg struct{}
+
+// SetRefreshInterval sets how often a pane refreshes itself; zero
+// disables auto-refresh for that pane. It applies from the next Init.
+func (m *Model) SetRefreshInterval(paneID string, interval time.Duration) {
+	m.refreshIntervals[paneID] = interval
+}
+
+// SetAutoRefresh enables or disables auto-refresh for all panes
+func (m *Model) SetAutoRefresh(enabled bool) {
+	m.autoRefresh = enabled
+}
+
+// scheduleAutoRefresh returns the next auto-refresh tick for a pane
+func (m *Model) scheduleAutoRefresh(paneID string) tea.Cmd {
+	if !m.autoRefresh {
+		return nil
+	}
+	interval := m.refreshIntervals[paneID]
+	if interval <= 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return autoRefreshMsg{paneID: paneID}
+	})
+}
+
+// handleAutoRefresh requests a debounced refresh of the pane, so it cannot
+// overlap a manual one, and schedules the next tick
+func (m *Model) handleAutoRefresh(msg autoRefreshMsg) tea.Cmd {
+	pane := m.GetPaneByID(msg.paneID)
+	if pane == nil || !m.autoRefresh {
+		return nil
+	}
+
+	return tea.Batch(
+		m.debounceRefresh(msg.paneID, refreshDebounce),
+		m.scheduleAutoRefresh(msg.paneID),
+	)
+}
+
+// debounceRefresh schedules a refresh of the pane after delay. A newer
+// request for the same pane supersedes any that is still pending.
+func (m *Model) debounceRefresh(paneID string, delay time.Duration) tea.Cmd {
+	m.refreshTokens[paneID]++
+	token := m.refreshTokens[paneID]
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return refreshRequestMsg{paneID: paneID, token: token}
+	})
+}
+
+// handleRefreshRequest refreshes the pane if msg is its latest request
+func (m *Model) handleRefreshRequest(msg refreshRequestMsg) tea.Cmd {
+	pane := m.GetPaneByID(msg.paneID)
+	if pane == nil || msg.token != m.refreshTokens[msg.paneID] {
+		return nil
+	}
+	return tea.Batch(pane.Refresh(), m.markRefreshed())
+}
+
+// markRefreshed shows the refresh indicator and schedules its removal
+func (m *Model) markRefreshed() tea.Cmd {
+	m.refreshIndicatorUntil = time.Now().Add(refreshIndicatorDuration)
+	return tea.Tick(refreshIndicatorDuration, func(time.Time) tea.Msg {
+		return refreshIndicatorMsg{}
+	})
+}
+
+func (m *Model) showRefreshIndicator() bool {
+	return time.Now().Before(m.refreshIndicatorUntil)
+}
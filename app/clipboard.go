@@ -0,0 +1,38 @@
+package app
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// clipboardCommands are tried in order; the first one installed is used
+var clipboardCommands = [][]string{
+	{"pbcopy"},
+	{"wl-copy"},
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+	{"clip.exe"},
+}
+
+// clipboardResultMsg reports how many lines were copied to the clipboard,
+// or why the copy failed
+type clipboardResultMsg struct {
+	lines int
+	err   error
+}
+
+// CopyToClipboard writes text to the system clipboard using whichever
+// clipboard tool is available
+func CopyToClipboard(text string) error {
+	for _, args := range clipboardCommands {
+		path, err := exec.LookPath(args[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return errors.New("no clipboard tool found")
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"strings"
+	"tui101/panes"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	globalContext  = "Global"
+	detailsContext = "Details"
+)
+
+// keyAction binds one or more keys to a global handler. A handler
+// returning nil lets the key fall through to the active pane.
+type keyAction struct {
+	keys        []string
+	description string
+	context     string
+	handler     func() tea.Cmd
+}
+
+// keyActions lists every global key binding; it drives both key
+// dispatch and the help overlay so the two cannot drift apart
+func (m *Model) keyActions() []keyAction {
+	return []keyAction{
+		{[]string{"q", "ctrl+c"}, "Quit", globalContext, func() tea.Cmd {
+			m.quitting = true
+			return tea.Quit
+		}},
+		{[]string{" "}, "Toggle focus between panes and details", globalContext, func() tea.Cmd {
+			m.toggleFocus()
+			return nil
+		}},
+		{[]string{"tab"}, "Next pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.nextPane)
+		}},
+		{[]string{"shift+tab"}, "Previous pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.prevPane)
+		}},
+		{[]string{"1"}, "Switch to pane 1", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(func() { m.setActivePane(0) })
+		}},
+		{[]string{"2"}, "Switch to pane 2", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(func() { m.setActivePane(1) })
+		}},
+		{[]string{"M"}, "Open the active pane's actions menu", globalContext, func() tea.Cmd {
+			if m.focus != FocusLeftPanes {
+				return nil
+			}
+			return m.openActionMenu()
+		}},
+		{[]string{"/"}, "Filter the active pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.startFilter)
+		}},
+		{[]string{"ctrl+f"}, "Toggle fullscreen for the active pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.toggleFullscreen)
+		}},
+		{[]string{"alt+left"}, "Go back to the previous pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.navigateBack)
+		}},
+		{[]string{"alt+right"}, "Go forward to the next pane", globalContext, func() tea.Cmd {
+			return m.handlePaneNavigation(m.navigateForward)
+		}},
+		{[]string{"ctrl+r"}, "Refresh all panes", globalContext, func() tea.Cmd {
+			return m.refreshAll()
+		}},
+		{[]string{"ctrl+y"}, "Copy the active pane's items to the clipboard", globalContext, func() tea.Cmd {
+			return m.copyActivePane()
+		}},
+		{[]string{macroRecordKey}, "Start/stop recording a macro", globalContext, func() tea.Cmd {
+			m.macro.Start()
+			return nil
+		}},
+		{[]string{macroPlayKey}, "Replay the recorded macro", globalContext, func() tea.Cmd {
+			return m.macro.Play()
+		}},
+		{[]string{"?"}, "Show keyboard shortcuts", globalContext, func() tea.Cmd {
+			return m.OpenOverlay(panes.NewKeybindingHelp(m.GetKeyBindings(), m.height))
+		}},
+		{[]string{"j", "down"}, "Move down", detailsContext, func() tea.Cmd {
+			return m.handleVerticalNavigation(true)
+		}},
+		{[]string{"k", "up"}, "Move up", detailsContext, func() tea.Cmd {
+			return m.handleVerticalNavigation(false)
+		}},
+		{[]string{"g"}, "Jump to top", detailsContext, func() tea.Cmd {
+			return m.handleJumpToTop()
+		}},
+		{[]string{"G"}, "Jump to bottom", detailsContext, func() tea.Cmd {
+			return m.handleJumpToBottom()
+		}},
+		{[]string{"w"}, "Toggle word wrap", detailsContext, func() tea.Cmd {
+			return m.handleToggleWordWrap()
+		}},
+	}
+}
+
+func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	for _, action := range m.keyActions() {
+		for _, k := range action.keys {
+			if k == key {
+				return action.handler()
+			}
+		}
+	}
+	return nil
+}
+
+// GetKeyBindings returns the global bindings followed by the active
+// pane's and the details pane's bindings
+func (m *Model) GetKeyBindings() []panes.KeyBinding {
+	var global, details []panes.KeyBinding
+	for _, action := range m.keyActions() {
+		binding := panes.KeyBinding{
+			Key:         formatKeys(action.keys),
+			Description: action.description,
+			Context:     action.context,
+		}
+		if action.context == detailsContext {
+			details = append(details, binding)
+		} else {
+			global = append(global, binding)
+		}
+	}
+
+	bindings := global
+	if pane := m.GetActivePane(); pane != nil {
+		bindings = append(bindings, pane.GetKeyBindings()...)
+	}
+	return append(bindings, details...)
+}
+
+func formatKeys(keys []string) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		if k == " " {
+			k = "space"
+		}
+		names[i] = k
+	}
+	return strings.Join(names, "/")
+}
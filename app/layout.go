@@ -3,20 +3,49 @@ package app
 import (
 	"fmt"
 	"strings"
+	"tui101/panes"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
+// paneBounds is the screen area a pane was last rendered into
+type paneBounds struct {
+	x, y, width, height int
+}
+
+func (b paneBounds) contains(x, y int) bool {
+	return x >= b.x && x < b.x+b.width && y >= b.y && y < b.y+b.height
+}
+
+// paneAt returns the index of the pane rendered at x, y, or -1
+func (m *Model) paneAt(x, y int) int {
+	for i, bounds := range m.paneBounds {
+		if bounds.contains(x, y) {
+			return i
+		}
+	}
+	return -1
+}
+
 // renderLayout renders the complete application layout
 func (m *Model) renderLayout(leftPaneWidth, rightPaneWidth, paneHeight int) string {
 	totalHeight := m.height
 	statusBarHeight := 1
 	availableHeight := totalHeight - statusBarHeight
 
-	leftPaneHeight := availableHeight / 4
+	m.paneBounds = make([]paneBounds, len(m.panes))
+
+	if m.fullscreenPane >= 0 && m.fullscreenPane < len(m.panes) {
+		fullscreen := m.renderFullscreenPane(m.width, availableHeight)
+		return lipgloss.JoinVertical(lipgloss.Left, fullscreen, m.renderStatusBar())
+	}
+
 	rightPaneHeight := availableHeight
 
-	leftPanes := m.renderLeftColumn(leftPaneWidth, leftPaneHeight)
+	// Pane styles draw two rows shorter than the height they are given,
+	// so the left column gets the same height the details pane ends up with
+	leftPanes := m.renderLeftColumn(leftPaneWidth, rightPaneHeight-2)
 
 	rightPane := m.renderRightColumn(rightPaneWidth, rightPaneHeight)
 
@@ -27,27 +56,148 @@ func (m *Model) renderLayout(leftPaneWidth, rightPaneWidth, paneHeight int) stri
 	return lipgloss.JoinVertical(lipgloss.Left, mainView, statusBar)
 }
 
-func (m *Model) renderLeftColumn(width, paneHeight int) string {
+// renderOverlay draws an overlay centered on top of the base view
+func (m *Model) renderOverlay(base string, overlay panes.Overlay) string {
+	box := strings.Split(overlay.View(), "\n")
+	boxWidth := lipgloss.Width(overlay.View())
+
+	lines := strings.Split(base, "\n")
+	for len(lines) < m.height {
+		lines = append(lines, "")
+	}
+
+	top := max((len(lines)-len(box))/2, 0)
+	left := max((m.width-boxWidth)/2, 0)
+	for i, boxLine := range box {
+		row := top + i
+		if row >= len(lines) {
+			break
+		}
+		line := lines[row]
+		if gap := left - lipgloss.Width(line); gap > 0 {
+			line += strings.Repeat(" ", gap)
+		}
+		boxLine += strings.Repeat(" ", boxWidth-lipgloss.Width(boxLine))
+		lines[row] = ansi.Truncate(line, left, "") + boxLine + ansi.TruncateLeft(line, left+boxWidth, "")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// paneChromeHeight is the rows a left pane spends on its border and title
+const paneChromeHeight = 3
+
+func (m *Model) renderLeftColumn(width, columnHeight int) string {
 	var panes []string
+	y := 0
 
-	for i := 0; i < len(m.panes) && i < 4; i++ {
+	heights, views := m.leftPaneHeights(width, columnHeight)
+	for i, paneHeight := range heights {
 		pane := m.panes[i]
 		// Left panes should only be active when focus is on left panes
 		isActive := i == m.activePane && m.focus == FocusLeftPanes
 
-		content := pane.View()
-		title := m.renderPaneTitle(pane.GetTitle(), i+1, isActive)
+		pane.SetHeight(paneHeight - paneChromeHeight)
+
+		// A pane given at least the height it was measured at draws the
+		// same content, so only squeezed panes are rendered again
+		content := views[i]
+		if paneHeight < lipgloss.Height(content)+paneChromeHeight {
+			content = pane.View()
+		}
+		title := m.renderPaneTitle(paneTitle(pane), i+1, isActive)
 		fullContent := title + "\n" + content
 
-		style := m.createPaneStyle(width, paneHeight, isActive)
+		// Pane styles subtract 4 rows but the border only takes 2
+		style := m.createPaneStyle(width, paneHeight+2, isActive)
 		renderedPane := style.Render(fullContent)
 
+		height := lipgloss.Height(renderedPane)
+		m.paneBounds[i] = paneBounds{0, y, lipgloss.Width(renderedPane), height}
+		y += height
+
 		panes = append(panes, renderedPane)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, panes...)
 }
 
+// leftPaneHeights splits columnHeight between the left panes. Panes whose
+// content fits in an even share get exactly what they need and the rest
+// is shared out among the others; any slack goes to the last pane. It also
+// returns the views the panes were measured with.
+func (m *Model) leftPaneHeights(width, columnHeight int) ([]int, []string) {
+	count := min(len(m.panes), 4)
+	if count == 0 {
+		return nil, nil
+	}
+
+	natural := make([]int, count)
+	views := make([]string, count)
+	for i := range natural {
+		pane := m.panes[i]
+		// Border and padding take 6 columns
+		pane.SetWidth(width - 6)
+		pane.SetHeight(columnHeight - paneChromeHeight)
+		views[i] = pane.View()
+		natural[i] = lipgloss.Height(views[i]) + paneChromeHeight
+		// Only the active pane draws its help text; reserve it for the
+		// others too so panes keep their size as focus moves
+		if !pane.IsActive() {
+			natural[i] += panes.HelpTextHeight
+		}
+	}
+
+	heights := make([]int, count)
+	remaining := columnHeight
+	pending := count
+	for settled := true; settled && pending > 0; {
+		settled = false
+		share := remaining / pending
+		for i, need := range natural {
+			if heights[i] == 0 && need <= share {
+				heights[i] = need
+				remaining -= need
+				pending--
+				settled = true
+			}
+		}
+	}
+
+	for i := range heights {
+		if heights[i] == 0 {
+			heights[i] = remaining / pending
+		}
+	}
+	used := 0
+	for _, h := range heights {
+		used += h
+	}
+	heights[count-1] += columnHeight - used
+
+	return heights, views
+}
+
+// renderFullscreenPane renders the maximized pane over the whole main area
+func (m *Model) renderFullscreenPane(width, height int) string {
+	pane := m.panes[m.fullscreenPane]
+	isActive := m.focus == FocusLeftPanes
+
+	// The style leaves height-4 rows inside the border, one is the title
+	pane.SetWidth(width - 6)
+	pane.SetHeight(height - 5)
+
+	content := pane.View()
+	title := m.renderPaneTitle(paneTitle(pane), m.fullscreenPane+1, isActive) +
+		m.styles.Dimmed.Render("[fullscreen]")
+	fullContent := title + "\n" + content
+
+	style := m.createPaneStyle(width, height, isActive)
+	rendered := style.Render(fullContent)
+	m.paneBounds[m.fullscreenPane] = paneBounds{0, 0, lipgloss.Width(rendered), lipgloss.Height(rendered)}
+	return rendered
+}
+
 func (m *Model) renderRightColumn(width, height int) string {
 	if m.activePane == 3 && len(m.panes) > 3 {
 		return m.renderGreetingPane(width, height)
@@ -65,8 +215,11 @@ func (m *Model) renderGreetingPane(width, height int) string {
 	// Greeting pane should only be active when focus is on left panes
 	isActive := m.activePane == 3 && m.focus == FocusLeftPanes
 
+	greetingPane.SetWidth(width - 6)
+	greetingPane.SetHeight(height - 5)
+
 	content := greetingPane.View()
-	title := m.renderPaneTitle(greetingPane.GetTitle(), 4, isActive)
+	title := m.renderPaneTitle(paneTitle(greetingPane), 4, isActive)
 	fullContent := title + "\n" + content
 
 	style := m.createPaneStyle(width, height, isActive)
@@ -78,7 +231,11 @@ func (m *Model) renderPreviewPane(width, height int) string {
 	isActive := m.focus == FocusDetails
 	title := m.renderPaneTitle("Details", 0, isActive)
 
-	previewContent := m.renderScrollablePreviewContent(height - 4) // Reserve space for title and borders
+	// Border and padding take 6 columns, the cursor prefix and selection
+	// padding 4 more
+	m.details.width = width - 10
+
+	previewContent := m.renderScrollablePreviewContent(m.previewRows(height))
 
 	fullContent := title + "\n" + previewContent
 
@@ -86,6 +243,14 @@ func (m *Model) renderPreviewPane(width, height int) string {
 	return style.Render(fullContent)
 }
 
+// paneTitle returns the pane's title, followed by the spinner while it loads
+func paneTitle(pane panes.Pane) string {
+	if pane.IsLoading() {
+		return pane.GetTitle() + " " + pane.SpinnerView()
+	}
+	return pane.GetTitle()
+}
+
 func (m *Model) renderPaneTitle(title string, number int, isActive bool) string {
 	titleStyle := m.styles.Title(isActive)
 
@@ -101,27 +266,36 @@ func (m *Model) createPaneStyle(width, height int, isActive bool) lipgloss.Style
 }
 
 func (m *Model) renderStatusBar() string {
-	currentPaneName := "Unknown"
-	if m.activePane < len(m.panes) {
-		currentPaneName = m.panes[m.activePane].GetTitle()
+	leftStatus := RenderStatusBar(m.statusBarFormat, m.statusBarData())
+	if m.showRefreshIndicator() {
+		leftStatus = "[↺] " + leftStatus
+	}
+	if m.macro.IsRecording() {
+		leftStatus = "[REC] " + leftStatus
 	}
 
-	var leftStatus string
-	if m.focus == FocusDetails {
-		leftStatus = "Active: Details | Space: Back to panes | j/k: Scroll | q: Quit"
+	var rightStatus string
+	if m.filterMode {
+		leftStatus = "Filter: /" + m.filterText
+		rightStatus = "Enter: Keep | Esc: Clear"
+	} else if notification := m.activeNotification(); notification != "" {
+		rightStatus = notification
+	} else if m.focus == FocusDetails {
+		rightStatus = "Space: Back to panes | ?: Help | q: Quit"
 	} else {
-		leftStatus = fmt.Sprintf("Active: %s | 1-2: Switch | Tab: Next | Space: Details | j/k: Scroll | q: Quit", currentPaneName)
+		rightStatus = "Space: Details | ?: Help | q: Quit"
 	}
 
-	rightStatus := "TUI101 v0.1.0"
+	// The status bar style pads one column on each side
+	available := m.width - 2
 
-	maxLeftLen := m.width - len(rightStatus) - 5
-	if len(leftStatus) > maxLeftLen {
-		leftStatus = leftStatus[:maxLeftLen-3] + "..."
+	maxLeftLen := available - lipgloss.Width(rightStatus) - 3
+	if lipgloss.Width(leftStatus) > maxLeftLen {
+		leftStatus = truncateRunes(leftStatus, maxLeftLen-3) + "..."
 	}
 
-	usedSpace := len(leftStatus) + len(rightStatus)
-	padding := m.width - usedSpace
+	usedSpace := lipgloss.Width(leftStatus) + lipgloss.Width(rightStatus)
+	padding := available - usedSpace
 	if padding < 0 {
 		padding = 0
 	}
@@ -133,8 +307,62 @@ func (m *Model) renderStatusBar() string {
 		Render(statusLine)
 }
 
+// statusBarData collects the values shown in the status bar from the
+// active pane and its selected item
+func (m *Model) statusBarData() StatusBarData {
+	data := StatusBarData{
+		Pane:    "Unknown",
+		Version: Version,
+	}
+
+	pane := m.GetActivePane()
+	if m.focus == FocusDetails {
+		data.Pane = "Details"
+	} else if pane != nil {
+		data.Pane = pane.GetTitle()
+	}
+
+	if pane == nil {
+		return data
+	}
+	if item := pane.GetSelectedItem(); item != nil {
+		if pkg, ok := item.Metadata.(panes.Package); ok {
+			data.Branch = pkg.Branch
+			data.Modified = pkg.ModifiedFiles
+			if pkg.HasUpstream {
+				data.Ahead = pkg.UpstreamAhead
+			}
+		}
+	}
+
+	return data
+}
+
+func truncateRunes(s string, n int) string {
+	if n < 0 {
+		n = 0
+	}
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+// previewRows returns how many details rows fit in a details pane of the
+// given height. The pane style keeps height-4 rows inside the border, one
+// is the title and, once the lines overflow, two go to the scroll
+// indicators.
+func (m *Model) previewRows(height int) int {
+	rows := height - 5
+	if len(m.details.displayLines()) > rows {
+		rows -= 2
+	}
+	return max(rows, 1)
+}
+
 func (m *Model) renderScrollablePreviewContent(maxLines int) string {
-	previewLines := m.GetPreviewLines()
+	previewLines := m.details.displayLines()
 	scrollPos := m.GetPreviewScrollPos()
 
 	if len(previewLines) == 0 {
@@ -156,25 +384,31 @@ func (m *Model) renderScrollablePreviewContent(maxLines int) string {
 	visibleLines := previewLines[start:end]
 
 	var styledLines []string
-	for i, line := range visibleLines {
-		actualIndex := start + i
-		isSelected := m.focus == FocusDetails && actualIndex == m.details.selectedLine
+	for _, line := range visibleLines {
+		isSelected := m.focus == FocusDetails && line.logical == m.details.selectedLine
+
+		prefix := "  "
+		if line.continuation {
+			prefix = m.styles.Dimmed.Render("↩ ")
+		} else if isSelected {
+			prefix = m.styles.Cursor.Render("> ")
+		}
 
 		if isSelected {
-			prefix := m.styles.Cursor.Render("> ")
-			styledLines = append(styledLines, m.styles.SelectedItem.Render(prefix+line))
+			styledLines = append(styledLines, m.styles.SelectedItem.Render(prefix+line.text))
 		} else {
-			styledLines = append(styledLines, "  "+line)
+			styledLines = append(styledLines, prefix+line.text)
 		}
 	}
 
 	result := strings.Join(styledLines, "\n")
 
+	clip := lipgloss.NewStyle().MaxWidth(m.details.width)
 	if scrollPos > 0 {
-		result = m.styles.Dimmed.Render("  ▲ more content above") + "\n" + result
+		result = clip.Render(m.styles.Dimmed.Render("  ▲ more content above")) + "\n" + result
 	}
 	if end < len(previewLines) {
-		result = result + "\n" + m.styles.Dimmed.Render("  ▼ more content below")
+		result = result + "\n" + clip.Render(m.styles.Dimmed.Render("  ▼ more content below"))
 	}
 
 	return result
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLayoutShowsAllItems(t *testing.T) {
+	sizes := []struct{ width, height int }{
+		{80, 24},
+		{120, 40},
+	}
+
+	for _, size := range sizes {
+		for active := 0; active < 2; active++ {
+			m := NewModel()
+			m.Update(tea.WindowSizeMsg{Width: size.width, Height: size.height})
+			m.activatePane(active)
+
+			view := m.View()
+			for _, pane := range m.GetPanes() {
+				for _, item := range pane.GetItems() {
+					if !strings.Contains(view, item.Display) {
+						t.Errorf("%dx%d, pane %d active: %s item %q not shown",
+							size.width, size.height, active, pane.GetID(), item.Display)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLayoutShowsSpinnerWhileRefreshing(t *testing.T) {
+	m := NewModel()
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	m.Init()
+
+	view := m.View()
+	for _, pane := range m.GetPanes() {
+		if !pane.IsLoading() {
+			t.Fatalf("%s pane not loading after Init", pane.GetID())
+		}
+		if !strings.Contains(view, pane.GetTitle()+" "+pane.SpinnerView()) {
+			t.Errorf("%s pane title has no spinner", pane.GetID())
+		}
+		for _, item := range pane.GetItems() {
+			if !strings.Contains(view, item.Display) {
+				t.Errorf("%s item %q hidden while refreshing", pane.GetID(), item.Display)
+			}
+		}
+	}
+}
+
+func TestLayoutKeepsCursorWithDetailsFocus(t *testing.T) {
+	m := NewModel()
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m.activatePane(1)
+	m.focus = FocusDetails
+
+	m.View()
+	if !m.panes[1].IsActive() {
+		t.Fatal("rendering deactivated the pane the details describe")
+	}
+	if !strings.Contains(m.View(), "❯") {
+		t.Error("selected row lost its cursor while details has focus")
+	}
+}
+
+func TestLayoutPaneSizesIgnoreFocus(t *testing.T) {
+	m := NewModel()
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	m.activatePane(0)
+	m.View()
+	before := append([]paneBounds(nil), m.paneBounds...)
+
+	m.activatePane(1)
+	m.View()
+	for i, bounds := range m.paneBounds {
+		if bounds != before[i] {
+			t.Errorf("pane %d moved from %+v to %+v when focus moved", i, before[i], bounds)
+		}
+	}
+}
+
+func TestLayoutFitsTerminal(t *testing.T) {
+	sizes := []struct{ width, height int }{
+		{80, 24},
+		{60, 20},
+		{120, 40},
+	}
+
+	for _, size := range sizes {
+		for active := 0; active < 2; active++ {
+			m := NewModel()
+			m.Update(tea.WindowSizeMsg{Width: size.width, Height: size.height})
+			m.activatePane(active)
+			m.View()
+			m.focus = FocusDetails
+			m.handleVerticalNavigation(true)
+
+			if got := strings.Count(m.View(), "\n") + 1; got > size.height {
+				t.Errorf("%dx%d, pane %d active: view is %d rows, taller than the terminal", size.width, size.height, active, got)
+			}
+		}
+	}
+}
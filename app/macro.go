@@ -0,0 +1,66 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	macroRecordKey = "Q"
+	macroPlayKey   = "@"
+	maxMacroKeys   = 50
+)
+
+// MacroRecorder captures a sequence of key presses for later replay
+type MacroRecorder struct {
+	recording bool
+	playback  bool
+	keyBuffer []tea.KeyMsg
+}
+
+// macroDoneMsg marks the end of a macro replay
+type macroDoneMsg struct{}
+
+// Start begins a new recording, discarding the previous macro
+func (r *MacroRecorder) Start() {
+	r.recording = true
+	r.keyBuffer = nil
+}
+
+// Stop ends the current recording
+func (r *MacroRecorder) Stop() {
+	r.recording = false
+}
+
+// IsRecording returns whether keys are being recorded
+func (r *MacroRecorder) IsRecording() bool {
+	return r.recording
+}
+
+// Record appends a key to the macro, stopping once the limit is reached
+func (r *MacroRecorder) Record(msg tea.KeyMsg) {
+	r.keyBuffer = append(r.keyBuffer, msg)
+	if len(r.keyBuffer) >= maxMacroKeys {
+		r.recording = false
+	}
+}
+
+// Play returns a command that re-injects the recorded keys in order
+func (r *MacroRecorder) Play() tea.Cmd {
+	if r.recording || r.playback || len(r.keyBuffer) == 0 {
+		return nil
+	}
+	r.playback = true
+
+	cmds := make([]tea.Cmd, 0, len(r.keyBuffer)+1)
+	for _, key := range r.keyBuffer {
+		cmds = append(cmds, func() tea.Msg { return key })
+	}
+	cmds = append(cmds, func() tea.Msg { return macroDoneMsg{} })
+
+	return tea.Sequence(cmds...)
+}
+
+// Done marks the replay as finished
+func (r *MacroRecorder) Done() {
+	r.playback = false
+}
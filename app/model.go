@@ -2,14 +2,20 @@ package app
 
 import (
 	"fmt"
+	"strings"
+	"time"
 	"tui101/panes"
 	"tui101/styles"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 type Focus int
 
+// refreshDebounce is how long refreshAll waits for further requests
+const refreshDebounce = 200 * time.Millisecond
+
 const (
 	FocusLeftPanes Focus = iota
 	FocusDetails
@@ -19,6 +25,16 @@ type DetailsPane struct {
 	selectedLine int
 	scrollPos    int
 	lines        []string
+	wordWrap     bool
+	width        int
+}
+
+// displayLine is one rendered row of a details line; long lines span
+// several rows when word wrap is on
+type displayLine struct {
+	text         string
+	logical      int
+	continuation bool
 }
 
 func (d *DetailsPane) Reset() {
@@ -57,18 +73,20 @@ func (d *DetailsPane) AdjustScroll(maxLines int) {
 		maxLines = 1
 	}
 
-	if d.selectedLine >= d.scrollPos+maxLines {
-		d.scrollPos = d.selectedLine - maxLines + 1
+	first, last := d.selectedRows()
+
+	if last >= d.scrollPos+maxLines {
+		d.scrollPos = last - maxLines + 1
 	}
 
-	if d.selectedLine < d.scrollPos {
-		d.scrollPos = d.selectedLine
+	if first < d.scrollPos {
+		d.scrollPos = first
 	}
 }
 
 func (d *DetailsPane) ScrollDown(maxLines int) {
-	if len(d.lines) > 0 {
-		maxScroll := len(d.lines) - maxLines
+	if rows := len(d.displayLines()); rows > 0 {
+		maxScroll := rows - maxLines
 		if maxScroll < 0 {
 			maxScroll = 0
 		}
@@ -84,6 +102,51 @@ func (d *DetailsPane) ScrollUp() {
 	}
 }
 
+func (d *DetailsPane) ToggleWordWrap() {
+	d.wordWrap = !d.wordWrap
+}
+
+// displayLines splits the details lines into rows that fit d.width,
+// wrapping or truncating long lines depending on wordWrap
+func (d *DetailsPane) displayLines() []displayLine {
+	var rows []displayLine
+	for i, line := range d.lines {
+		if d.width <= 0 || lipgloss.Width(line) <= d.width {
+			rows = append(rows, displayLine{text: line, logical: i})
+			continue
+		}
+
+		if !d.wordWrap {
+			truncated := lipgloss.NewStyle().MaxWidth(d.width).Render(line)
+			rows = append(rows, displayLine{text: truncated, logical: i})
+			continue
+		}
+
+		wrapped := lipgloss.NewStyle().Width(d.width).Render(line)
+		for j, part := range strings.Split(wrapped, "\n") {
+			rows = append(rows, displayLine{text: part, logical: i, continuation: j > 0})
+		}
+	}
+	return rows
+}
+
+// selectedRows returns the first and last display rows of the selected line
+func (d *DetailsPane) selectedRows() (int, int) {
+	first, last := -1, -1
+	for i, row := range d.displayLines() {
+		if row.logical == d.selectedLine {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return d.selectedLine, d.selectedLine
+	}
+	return first, last
+}
+
 type Model struct {
 	panes      []panes.Pane
 	activePane int
@@ -95,6 +158,34 @@ type Model struct {
 	filterText string
 	details    DetailsPane
 	focus      Focus
+	overlays   []panes.Overlay
+	macro      MacroRecorder
+
+	// fullscreenPane is the index of the maximized pane, or -1
+	fullscreenPane int
+
+	history NavigationHistory
+
+	refreshIntervals      map[string]time.Duration
+	refreshTokens         map[string]int64
+	autoRefresh           bool
+	refreshIndicatorUntil time.Time
+
+	// paneBounds holds where each pane was last rendered, for mouse input
+	paneBounds []paneBounds
+
+	// lastClickTime and lastClickPos detect double-clicks
+	lastClickTime time.Time
+	lastClickPos  clickPos
+
+	// pendingResize is the latest terminal size not yet applied
+	pendingResize *tea.WindowSizeMsg
+	resizeSeq     int
+
+	statusBarFormat string
+
+	notification      string
+	notificationUntil time.Time
 }
 
 func NewModel() *Model {
@@ -102,6 +193,16 @@ func NewModel() *Model {
 		styles:     styles.NewStyles(),
 		activePane: 0, // Start with workspace pane active
 		focus:      FocusLeftPanes,
+
+		statusBarFormat: DefaultStatusBarFormat,
+		fullscreenPane:  -1,
+		autoRefresh:     true,
+	}
+
+	m.refreshTokens = make(map[string]int64)
+	m.refreshIntervals = make(map[string]time.Duration, len(defaultRefreshIntervals))
+	for id, interval := range defaultRefreshIntervals {
+		m.refreshIntervals[id] = interval
 	}
 
 	m.panes = []panes.Pane{
@@ -117,6 +218,7 @@ func (m *Model) Init() tea.Cmd {
 
 	for _, pane := range m.panes {
 		cmds = append(cmds, pane.Init())
+		cmds = append(cmds, m.scheduleAutoRefresh(pane.GetID()))
 	}
 
 	return tea.Batch(cmds...)
@@ -127,25 +229,77 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+		return m, m.queueResize(msg)
+
+	case ApplyResizeMsg:
+		m.handleApplyResize(msg)
+		return m, nil
+
+	case panes.EscapeMsg:
+		return m, m.CloseOverlay()
+
+	case macroDoneMsg:
+		m.macro.Done()
+		return m, nil
+
+	case SelectResultMsg:
+		if paneID, ok := strings.CutPrefix(msg.ID, actionMenuPrefix); ok && !msg.Cancelled {
+			if pane := m.GetPaneByID(paneID); pane != nil {
+				return m, pane.HandleAction(msg.Value)
+			}
+		}
+		return m, nil
+
+	case tea.MouseMsg:
+		return m, m.handleMouseMsg(msg)
+
+	case refreshRequestMsg:
+		return m, m.handleRefreshRequest(msg)
+
+	case autoRefreshMsg:
+		return m, m.handleAutoRefresh(msg)
+
+	case clipboardResultMsg:
+		return m, m.handleClipboardResult(msg)
+
+	case refreshIndicatorMsg, notificationExpiredMsg:
 		return m, nil
 
 	case tea.KeyMsg:
-		// Handle space key first before anything else
-		if msg.String() == " " {
-			m.toggleFocus()
+		if msg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		// While recording, keys are captured instead of processed
+		if m.macro.IsRecording() {
+			if msg.String() == macroRecordKey {
+				m.macro.Stop()
+			} else {
+				m.macro.Record(msg)
+			}
+			return m, nil
+		}
+
+		if m.filterMode {
+			m.handleFilterKey(msg)
 			return m, nil
 		}
 
+		// Open overlays take all key input
+		if len(m.overlays) > 0 {
+			return m, m.updateTopOverlay(msg)
+		}
+
 		// Handle global keybindings
 		cmd := m.handleKeyMsg(msg)
 		if cmd != nil {
 			return m, cmd
 		}
 
-		// Don't pass keys to panes if focus is on details
-		if m.focus == FocusDetails {
+		// Don't pass keys to panes if focus is on details or a global
+		// binding opened an overlay or the filter prompt
+		if m.focus == FocusDetails || len(m.overlays) > 0 || m.filterMode {
 			return m, nil
 		}
 
@@ -159,6 +313,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	default:
+		if len(m.overlays) > 0 {
+			if cmd := m.updateTopOverlay(msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
 		for i, pane := range m.panes {
 			updatedPane, cmd := pane.Update(msg)
 			m.panes[i] = updatedPane
@@ -171,39 +331,124 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "q", "ctrl+c":
-		m.quitting = true
-		return tea.Quit
+// SetStatusBarFormat sets the status bar template, see RenderStatusBar
+func (m *Model) SetStatusBarFormat(format string) {
+	m.statusBarFormat = format
+}
 
-	case "tab":
-		return m.handlePaneNavigation(m.nextPane)
-	case "shift+tab":
-		return m.handlePaneNavigation(m.prevPane)
+// OpenOverlay pushes an overlay on top of the layout
+func (m *Model) OpenOverlay(o panes.Overlay) tea.Cmd {
+	m.overlays = append(m.overlays, o)
+	return o.Init()
+}
 
-	case "1":
-		return m.handlePaneNavigation(func() { m.setActivePane(0) })
-	case "2":
-		return m.handlePaneNavigation(func() { m.setActivePane(1) })
+// CloseOverlay pops the top overlay, if any
+func (m *Model) CloseOverlay() tea.Cmd {
+	if len(m.overlays) > 0 {
+		m.overlays = m.overlays[:len(m.overlays)-1]
+	}
+	return nil
+}
 
-	case "ctrl+r":
-		return m.refreshAll()
+func (m *Model) updateTopOverlay(msg tea.Msg) tea.Cmd {
+	top := len(m.overlays) - 1
+	updated, cmd := m.overlays[top].Update(msg)
+	m.overlays[top] = updated
+	return cmd
+}
 
-	case "?":
-		return tea.Batch()
+// startFilter begins typing a filter for the active pane
+func (m *Model) startFilter() {
+	m.filterMode = true
+	m.filterText = ""
+	if pane := m.GetActivePane(); pane != nil {
+		m.filterText = pane.GetFilter()
+	}
+}
 
-	case "j", "down":
-		return m.handleVerticalNavigation(true)
-	case "k", "up":
-		return m.handleVerticalNavigation(false)
-	case "g":
-		return m.handleJumpToTop()
-	case "G":
-		return m.handleJumpToBottom()
+// handleFilterKey edits the filter query: enter keeps the filter, esc
+// clears it, and every edit is applied to the active pane immediately
+func (m *Model) handleFilterKey(msg tea.KeyMsg) {
+	pane := m.GetActivePane()
+	if pane == nil {
+		m.filterMode = false
+		return
 	}
 
-	return nil
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filterMode = false
+		return
+	case tea.KeyEsc:
+		m.filterMode = false
+		m.filterText = ""
+		pane.ClearFilter()
+		return
+	case tea.KeyBackspace:
+		if runes := []rune(m.filterText); len(runes) > 0 {
+			m.filterText = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.filterText += string(msg.Runes)
+	default:
+		return
+	}
+
+	pane.SetFilter(m.filterText)
+}
+
+// copyActivePane copies the active pane's items to the clipboard. The
+// clipboard tool runs in a command so it cannot block the event loop.
+func (m *Model) copyActivePane() tea.Cmd {
+	pane := m.GetActivePane()
+	if pane == nil {
+		return nil
+	}
+
+	text := pane.ExportText()
+	return func() tea.Msg {
+		lines := 0
+		if text != "" {
+			lines = strings.Count(text, "\n") + 1
+		}
+		return clipboardResultMsg{lines: lines, err: CopyToClipboard(text)}
+	}
+}
+
+// handleClipboardResult reports how a copy to the clipboard went
+func (m *Model) handleClipboardResult(msg clipboardResultMsg) tea.Cmd {
+	if msg.err != nil {
+		return m.notify("Copy failed: " + msg.err.Error())
+	}
+	return m.notify(fmt.Sprintf("Copied %d lines to clipboard", msg.lines))
+}
+
+// actionMenuPrefix tags SelectMenu results that carry a pane action; the
+// rest of the ID is the pane ID
+const actionMenuPrefix = "actions:"
+
+// openActionMenu lists the active pane's actions in a SelectMenu
+func (m *Model) openActionMenu() tea.Cmd {
+	pane := m.GetActivePane()
+	if pane == nil {
+		return nil
+	}
+
+	labels := pane.GetActionLabels()
+	var options []SelectOption
+	for _, action := range pane.GetAvailableActions() {
+		label, ok := labels[action]
+		if !ok {
+			label = action
+		}
+		options = append(options, SelectOption{Label: label, Value: action})
+	}
+	if len(options) == 0 {
+		return nil
+	}
+
+	title := pane.GetTitle() + " Actions"
+	return m.OpenOverlay(NewSelectMenu(actionMenuPrefix+pane.GetID(), title, options))
 }
 
 func (m *Model) handlePaneNavigation(navFunc func()) tea.Cmd {
@@ -220,18 +465,26 @@ func (m *Model) handleVerticalNavigation(down bool) tea.Cmd {
 		} else {
 			m.details.MoveUp()
 		}
-		m.details.AdjustScroll(m.height - 5)
+		m.details.AdjustScroll(m.previewRows(m.height - 1))
 		return tea.Batch()
 	}
 
 	if down {
-		m.details.ScrollDown(m.height - 5)
+		m.details.ScrollDown(m.previewRows(m.height - 1))
 	} else {
 		m.details.ScrollUp()
 	}
 	return tea.Batch()
 }
 
+func (m *Model) handleToggleWordWrap() tea.Cmd {
+	if m.focus == FocusDetails {
+		m.details.ToggleWordWrap()
+		m.details.AdjustScroll(m.previewRows(m.height - 1))
+	}
+	return nil
+}
+
 func (m *Model) handleJumpToTop() tea.Cmd {
 	if m.focus == FocusDetails {
 		m.details.MoveToTop()
@@ -243,14 +496,26 @@ func (m *Model) handleJumpToTop() tea.Cmd {
 func (m *Model) handleJumpToBottom() tea.Cmd {
 	if m.focus == FocusDetails {
 		m.details.MoveToBottom()
-		m.details.AdjustScroll(m.height - 5)
+		m.details.AdjustScroll(m.previewRows(m.height - 1))
 		return tea.Batch()
 	}
 	return nil
 }
 
+func (m *Model) toggleFullscreen() {
+	if m.fullscreenPane >= 0 {
+		m.fullscreenPane = -1
+	} else {
+		m.fullscreenPane = m.activePane
+	}
+}
+
+// toggleFocus switches focus between the left panes and the details pane.
+// The details pane is not drawn in fullscreen, so focusing it leaves
+// fullscreen.
 func (m *Model) toggleFocus() {
 	if m.focus == FocusLeftPanes {
+		m.fullscreenPane = -1
 		m.focus = FocusDetails
 		m.details.Reset()
 	} else {
@@ -266,9 +531,20 @@ func (m *Model) prevPane() {
 	m.setActivePane((m.activePane - 1 + len(m.panes)) % len(m.panes))
 }
 
+// setActivePane switches panes and records the move in the history
 func (m *Model) setActivePane(index int) {
+	if index >= 0 && index < len(m.panes) && index != m.activePane {
+		m.history.Visit(m.currentLocation(), m.locationOf(index))
+	}
+	m.activatePane(index)
+}
+
+func (m *Model) activatePane(index int) {
 	if index >= 0 && index < len(m.panes) {
 		m.activePane = index
+		if m.fullscreenPane >= 0 {
+			m.fullscreenPane = index
+		}
 		for i, pane := range m.panes {
 			pane.SetActive(i == index)
 		}
@@ -278,9 +554,7 @@ func (m *Model) setActivePane(index int) {
 func (m *Model) refreshAll() tea.Cmd {
 	var cmds []tea.Cmd
 	for _, pane := range m.panes {
-		if cmd := pane.Refresh(); cmd != nil {
-			cmds = append(cmds, cmd)
-		}
+		cmds = append(cmds, m.debounceRefresh(pane.GetID(), refreshDebounce))
 	}
 	return tea.Batch(cmds...)
 }
@@ -310,7 +584,11 @@ func (m *Model) View() string {
 
 	m.updateDiffContent()
 
-	return m.renderLayout(leftPaneWidth, rightPaneWidth, leftPaneHeight)
+	view := m.renderLayout(leftPaneWidth, rightPaneWidth, leftPaneHeight)
+	if len(m.overlays) > 0 {
+		view = m.renderOverlay(view, m.overlays[len(m.overlays)-1])
+	}
+	return view
 }
 
 func (m *Model) updateDiffContent() {
@@ -398,9 +676,7 @@ func (m *Model) formatPackageDetails(item *panes.PaneItem) []string {
 		details = append(details, "")
 		details = append(details, m.styles.Dimmed.Render("Available Actions:"))
 		details = append(details, m.styles.Dimmed.Render("  • Press 'r' to refresh"))
-		if pkg.HasUpstream {
-			details = append(details, m.styles.Dimmed.Render("  • Press 's' to sync with mainline"))
-		}
+		details = append(details, m.styles.Dimmed.Render("  • Press 's' to cycle sort order"))
 		details = append(details, m.styles.Dimmed.Render("  • Press 'c' to switch branch"))
 
 	} else {
@@ -414,6 +690,10 @@ func (m *Model) formatPackageDetails(item *panes.PaneItem) []string {
 }
 
 func (m *Model) formatWorkspaceDetails(item *panes.PaneItem) []string {
+	if ssh, ok := item.Metadata.(panes.SSHStatus); ok {
+		return m.formatSSHDetails(ssh)
+	}
+
 	var details []string
 	details = append(details, "Workspace Details:")
 	details = append(details, "")
@@ -423,6 +703,36 @@ func (m *Model) formatWorkspaceDetails(item *panes.PaneItem) []string {
 	return details
 }
 
+func (m *Model) formatSSHDetails(ssh panes.SSHStatus) []string {
+	var details []string
+	details = append(details, "")
+	details = append(details, m.styles.Highlight.Render("  SSH Agent"))
+	details = append(details, "")
+
+	if ssh.Err != nil {
+		details = append(details, m.styles.WarningText.Render("  Could not query the SSH agent"))
+		details = append(details, "    "+m.styles.Dimmed.Render(ssh.Err.Error()))
+		return details
+	}
+
+	if !ssh.AgentRunning {
+		details = append(details, m.styles.WarningText.Render("  No SSH agent is running"))
+		details = append(details, "    "+m.styles.Dimmed.Render("(start one with: eval $(ssh-agent))"))
+		return details
+	}
+
+	details = append(details, m.styles.WorkspaceName.Render("Loaded Keys"))
+	if len(ssh.Fingerprints) == 0 {
+		details = append(details, m.styles.WarningText.Render("  No keys loaded"))
+		details = append(details, "    "+m.styles.Dimmed.Render("(add one with: ssh-add)"))
+		return details
+	}
+	for _, fingerprint := range ssh.Fingerprints {
+		details = append(details, "  "+fingerprint)
+	}
+	return details
+}
+
 func (m *Model) formatGenericDetails(item *panes.PaneItem, paneName string) []string {
 	var details []string
 	details = append(details, "Selected Item Details:")
@@ -466,6 +776,29 @@ func (m *Model) GetPanes() []panes.Pane {
 	return m.panes
 }
 
+// GetPaneByID returns the pane with the given ID, or nil. Update replaces
+// entries of m.panes and panes are not synchronized, so it must be called
+// from Update or View, never from a tea.Cmd.
+func (m *Model) GetPaneByID(id string) panes.Pane {
+	for _, pane := range m.panes {
+		if pane.GetID() == id {
+			return pane
+		}
+	}
+	return nil
+}
+
+// GetPaneByType returns the first pane of the given type, or nil. Like
+// GetPaneByID it must be called from Update or View.
+func (m *Model) GetPaneByType(paneType panes.PaneType) panes.Pane {
+	for _, pane := range m.panes {
+		if pane.GetType() == paneType {
+			return pane
+		}
+	}
+	return nil
+}
+
 func (m *Model) GetDimensions() (int, int) {
 	return m.width, m.height
 }
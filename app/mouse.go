@@ -0,0 +1,85 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// doubleClickInterval is the longest gap between two clicks of a double-click
+const doubleClickInterval = 300 * time.Millisecond
+
+// paneContentTop is the number of rows above a pane's View output: the
+// top border and the title line
+const paneContentTop = 2
+
+// clickPos is a screen cell a mouse click landed on
+type clickPos struct {
+	x, y int
+}
+
+// handleMouseMsg focuses and selects on left-click, runs the pane's
+// item action on double-click and opens the actions menu on right-click
+func (m *Model) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
+	if len(m.overlays) > 0 || m.filterMode || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	index := m.paneAt(msg.X, msg.Y)
+	if index < 0 {
+		return nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonLeft:
+		return m.handleLeftClick(index, msg.X, msg.Y)
+	case tea.MouseButtonRight:
+		m.focus = FocusLeftPanes
+		m.setActivePane(index)
+		return m.openActionMenu()
+	}
+	return nil
+}
+
+// handleLeftClick focuses the pane and selects the clicked item
+func (m *Model) handleLeftClick(index, x, y int) tea.Cmd {
+	m.focus = FocusLeftPanes
+	m.setActivePane(index)
+
+	pane := m.panes[index]
+	item := pane.ItemAt(y - m.paneBounds[index].y - paneContentTop)
+	if item < 0 {
+		m.lastClickTime = time.Time{}
+		return nil
+	}
+	pane.SelectItem(item)
+
+	pos := clickPos{x, y}
+	now := time.Now()
+	doubleClick := pos == m.lastClickPos && now.Sub(m.lastClickTime) <= doubleClickInterval
+	m.lastClickPos = pos
+	m.lastClickTime = now
+	if !doubleClick {
+		return nil
+	}
+
+	// Reset so a third click starts a new double-click
+	m.lastClickTime = time.Time{}
+	action := primaryAction(pane.GetAvailableActions())
+	if action == "" {
+		return nil
+	}
+	return pane.HandleAction(action)
+}
+
+// primaryAction returns the pane's item-level action, "open", or "" when
+// the pane has none; pane-wide actions such as refresh never run on a
+// double-click
+func primaryAction(actions []string) string {
+	for _, action := range actions {
+		if action == "open" {
+			return action
+		}
+	}
+	return ""
+}
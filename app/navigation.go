@@ -0,0 +1,104 @@
+package app
+
+const maxNavHistory = 50
+
+// NavEntry is a visited location: a pane and the item selected in it
+type NavEntry struct {
+	PaneIndex int
+	ItemValue string
+	ItemIndex int
+}
+
+// NavigationHistory records pane switches for back/forward navigation
+type NavigationHistory struct {
+	stack  []NavEntry
+	cursor int
+}
+
+// Visit records a move from one location to another, dropping any
+// forward history
+func (h *NavigationHistory) Visit(from, to NavEntry) {
+	if len(h.stack) == 0 {
+		h.stack = []NavEntry{from}
+		h.cursor = 0
+	} else {
+		h.stack[h.cursor] = from
+	}
+
+	h.stack = append(h.stack[:h.cursor+1], to)
+	if len(h.stack) > maxNavHistory {
+		h.stack = h.stack[len(h.stack)-maxNavHistory:]
+	}
+	h.cursor = len(h.stack) - 1
+}
+
+// Back returns the previous location, remembering current for Forward
+func (h *NavigationHistory) Back(current NavEntry) (NavEntry, bool) {
+	if h.cursor <= 0 || len(h.stack) == 0 {
+		return NavEntry{}, false
+	}
+	h.stack[h.cursor] = current
+	h.cursor--
+	return h.stack[h.cursor], true
+}
+
+// Forward returns the next location, remembering current for Back
+func (h *NavigationHistory) Forward(current NavEntry) (NavEntry, bool) {
+	if h.cursor >= len(h.stack)-1 {
+		return NavEntry{}, false
+	}
+	h.stack[h.cursor] = current
+	h.cursor++
+	return h.stack[h.cursor], true
+}
+
+// currentLocation returns the active pane and its selected item
+func (m *Model) currentLocation() NavEntry {
+	return m.locationOf(m.activePane)
+}
+
+func (m *Model) locationOf(index int) NavEntry {
+	entry := NavEntry{PaneIndex: index}
+	if index >= 0 && index < len(m.panes) {
+		pane := m.panes[index]
+		entry.ItemIndex = pane.GetSelectedIndex()
+		if item := pane.GetSelectedItem(); item != nil {
+			entry.ItemValue = item.Value
+		}
+	}
+	return entry
+}
+
+func (m *Model) navigateBack() {
+	if entry, ok := m.history.Back(m.currentLocation()); ok {
+		m.restoreLocation(entry)
+	}
+}
+
+func (m *Model) navigateForward() {
+	if entry, ok := m.history.Forward(m.currentLocation()); ok {
+		m.restoreLocation(entry)
+	}
+}
+
+// restoreLocation activates a recorded location. If its item is gone
+// after a refresh, the closest remaining position is selected instead.
+func (m *Model) restoreLocation(entry NavEntry) {
+	if entry.PaneIndex < 0 || entry.PaneIndex >= len(m.panes) {
+		return
+	}
+	m.activatePane(entry.PaneIndex)
+
+	pane := m.panes[entry.PaneIndex]
+	if entry.ItemValue != "" && pane.JumpTo(entry.ItemValue) {
+		return
+	}
+	index := entry.ItemIndex
+	if index >= pane.GetItemCount() {
+		index = pane.GetItemCount() - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	pane.SelectItem(index)
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// notificationDuration is how long a notification stays in the status bar
+const notificationDuration = 3 * time.Second
+
+// notificationExpiredMsg re-renders once a notification expires
+type notificationExpiredMsg struct{}
+
+// notify shows text on the right of the status bar for a few seconds
+func (m *Model) notify(text string) tea.Cmd {
+	m.notification = text
+	m.notificationUntil = time.Now().Add(notificationDuration)
+	return tea.Tick(notificationDuration, func(time.Time) tea.Msg {
+		return notificationExpiredMsg{}
+	})
+}
+
+// activeNotification returns the current notification, or "" once expired
+func (m *Model) activeNotification() string {
+	if time.Now().Before(m.notificationUntil) {
+		return m.notification
+	}
+	return ""
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// resizeDebounce is how long the terminal size must stay put before the
+// layout is rebuilt, so a drag-resize renders once rather than per event
+const resizeDebounce = 50 * time.Millisecond
+
+// ApplyResizeMsg fires when a pending terminal resize has settled
+type ApplyResizeMsg struct {
+	seq int
+}
+
+// queueResize stores the latest size and restarts the debounce tick. The
+// first size is applied at once so the initial render isn't delayed.
+func (m *Model) queueResize(msg tea.WindowSizeMsg) tea.Cmd {
+	if m.width == 0 || m.height == 0 {
+		m.applyResize(msg)
+		return nil
+	}
+
+	m.pendingResize = &msg
+	m.resizeSeq++
+	seq := m.resizeSeq
+	return tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+		return ApplyResizeMsg{seq: seq}
+	})
+}
+
+// handleApplyResize applies the pending resize unless a newer one has
+// restarted the tick since this one was scheduled
+func (m *Model) handleApplyResize(msg ApplyResizeMsg) {
+	if msg.seq != m.resizeSeq || m.pendingResize == nil {
+		return
+	}
+	m.applyResize(*m.pendingResize)
+	m.pendingResize = nil
+}
+
+// applyResize records the new terminal size; panes pick up their own
+// width and height from it on the next render
+func (m *Model) applyResize(msg tea.WindowSizeMsg) {
+	m.width = msg.Width
+	m.height = msg.Height
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"tui101/panes"
+	"tui101/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SelectOption is one choice in a SelectMenu
+type SelectOption struct {
+	Label       string
+	Value       string
+	Description string
+}
+
+// SelectResultMsg reports the option picked from the SelectMenu with
+// the given ID, or that the menu was cancelled
+type SelectResultMsg struct {
+	ID        string
+	Value     string
+	Cancelled bool
+}
+
+// SelectMenu is an overlay for picking one of several options
+type SelectMenu struct {
+	ID       string
+	Title    string
+	Options  []SelectOption
+	selected int
+	st       *styles.Styles
+}
+
+// NewSelectMenu creates a menu whose result is tagged with id
+func NewSelectMenu(id, title string, options []SelectOption) *SelectMenu {
+	return &SelectMenu{
+		ID:      id,
+		Title:   title,
+		Options: options,
+		st:      styles.NewStyles(),
+	}
+}
+
+func (s *SelectMenu) Init() tea.Cmd {
+	return nil
+}
+
+func (s *SelectMenu) Update(msg tea.Msg) (panes.Overlay, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return s, nil
+	}
+
+	switch keyMsg.String() {
+	case "j", "down":
+		if s.selected < len(s.Options)-1 {
+			s.selected++
+		}
+	case "k", "up":
+		if s.selected > 0 {
+			s.selected--
+		}
+	case "enter":
+		if len(s.Options) == 0 {
+			return s, s.close(SelectResultMsg{ID: s.ID, Cancelled: true})
+		}
+		return s, s.close(SelectResultMsg{ID: s.ID, Value: s.Options[s.selected].Value})
+	case "esc":
+		return s, s.close(SelectResultMsg{ID: s.ID, Cancelled: true})
+	}
+
+	return s, nil
+}
+
+func (s *SelectMenu) View() string {
+	var lines []string
+	lines = append(lines, s.st.ActiveTitle.Render(s.Title))
+	lines = append(lines, "")
+
+	for i, option := range s.Options {
+		if i == s.selected {
+			lines = append(lines, s.st.SelectedItem.Render(s.st.RenderCursor(true)+option.Label))
+		} else {
+			lines = append(lines, s.st.UnselectedItem.Render("  "+option.Label))
+		}
+		if option.Description != "" {
+			lines = append(lines, s.st.Dimmed.Render("      "+option.Description))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, s.st.Dimmed.Render("j/k: Navigate  enter: Select  esc: Cancel"))
+
+	return s.st.ActiveBorder.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// close dismisses the menu before delivering its result
+func (s *SelectMenu) close(result SelectResultMsg) tea.Cmd {
+	return tea.Sequence(panes.Escape, func() tea.Msg { return result })
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version is the application name and version shown in the status bar
+const Version = "TUI101 v0.1.0"
+
+// DefaultStatusBarFormat is the status bar layout used unless overridden
+const DefaultStatusBarFormat = "{pane} | {branch} ↑{ahead} ↓{behind} | +{staged} ~{modified} ?{untracked} | {version}"
+
+// StatusBarData holds the live values substituted into the status bar format
+type StatusBarData struct {
+	Pane      string
+	Branch    string
+	Version   string
+	Ahead     int
+	Behind    int
+	Staged    int
+	Modified  int
+	Untracked int
+	Conflicts int
+}
+
+// RenderStatusBar replaces the tokens in format with values from data.
+// Words whose tokens are zero or empty are dropped along with their
+// labels, and "|"-separated sections left empty are removed.
+func RenderStatusBar(format string, data StatusBarData) string {
+	tokens := map[string]string{
+		"{pane}":      data.Pane,
+		"{branch}":    data.Branch,
+		"{version}":   data.Version,
+		"{ahead}":     countToken(data.Ahead),
+		"{behind}":    countToken(data.Behind),
+		"{staged}":    countToken(data.Staged),
+		"{modified}":  countToken(data.Modified),
+		"{untracked}": countToken(data.Untracked),
+		"{conflicts}": countToken(data.Conflicts),
+	}
+
+	var sections []string
+	for _, section := range strings.Split(format, "|") {
+		var words []string
+		for _, word := range strings.Fields(section) {
+			if rendered, ok := renderWord(word, tokens); ok {
+				words = append(words, rendered)
+			}
+		}
+		if len(words) > 0 {
+			sections = append(sections, strings.Join(words, " "))
+		}
+	}
+
+	return strings.Join(sections, " | ")
+}
+
+// renderWord substitutes every token in word, reporting false if any of
+// them is empty so the whole word can be suppressed
+func renderWord(word string, tokens map[string]string) (string, bool) {
+	for token, value := range tokens {
+		if !strings.Contains(word, token) {
+			continue
+		}
+		if value == "" {
+			return "", false
+		}
+		word = strings.ReplaceAll(word, token, value)
+	}
+	return word, true
+}
+
+func countToken(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
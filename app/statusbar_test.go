@@ -0,0 +1,60 @@
+package app
+
+import "testing"
+
+func TestRenderStatusBar(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   StatusBarData
+		want   string
+	}{
+		{
+			name:   "all tokens set",
+			format: DefaultStatusBarFormat,
+			data: StatusBarData{
+				Pane:      "Packages",
+				Branch:    "main",
+				Version:   Version,
+				Ahead:     3,
+				Behind:    1,
+				Staged:    2,
+				Modified:  5,
+				Untracked: 4,
+			},
+			want: "Packages | main ↑3 ↓1 | +2 ~5 ?4 | " + Version,
+		},
+		{
+			name:   "zero counts drop empty sections",
+			format: DefaultStatusBarFormat,
+			data:   StatusBarData{Pane: "Workspace", Version: Version},
+			want:   "Workspace | " + Version,
+		},
+		{
+			name:   "sparse counts keep their section",
+			format: DefaultStatusBarFormat,
+			data:   StatusBarData{Pane: "Packages", Branch: "main", Version: Version, Modified: 5},
+			want:   "Packages | main | ~5 | " + Version,
+		},
+		{
+			name:   "word with mixed tokens is dropped if any is empty",
+			format: "{pane} | {branch}:{ahead} | {staged}/{modified}",
+			data:   StatusBarData{Pane: "Packages", Branch: "main", Staged: 1, Modified: 2},
+			want:   "Packages | 1/2",
+		},
+		{
+			name:   "word with mixed tokens all set",
+			format: "{branch}:{ahead}",
+			data:   StatusBarData{Branch: "main", Ahead: 3},
+			want:   "main:3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderStatusBar(tt.format, tt.data); got != tt.want {
+				t.Errorf("RenderStatusBar() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // PaneType represents different types of panes
@@ -43,6 +45,7 @@ type Pane interface {
 
 	// Selection and navigation
 	GetSelectedItem() *PaneItem
+	GetSelectedIndex() int
 	GetItems() []PaneItem
 	GetItemCount() int
 	MoveUp()
@@ -50,16 +53,23 @@ type Pane interface {
 	MoveToTop()
 	MoveToBottom()
 	SelectItem(index int)
+	ItemAt(row int) int
+	JumpTo(value string) bool
 
 	// State management
 	IsActive() bool
 	SetActive(bool)
 	IsLoading() bool
 	SetLoading(bool)
+	SpinnerView() string
 
 	// Data operations
 	Refresh() tea.Cmd
 	Filter(string) []PaneItem
+	SetFilter(string)
+	ClearFilter()
+	GetFilter() string
+	GetSupportedSortOrders() []SortOrder
 	Clear()
 	AddItem(PaneItem)
 	RemoveItem(index int)
@@ -67,12 +77,17 @@ type Pane interface {
 	// Actions
 	HandleAction(action string) tea.Cmd
 	GetAvailableActions() []string
+	GetActionLabels() map[string]string
+	GetKeyBindings() []KeyBinding
+	ExportText() string
 
 	// Display options
 	ShowLineNumbers() bool
 	SetShowLineNumbers(bool)
 	GetMaxDisplayItems() int
 	SetMaxDisplayItems(int)
+	SetWidth(int)
+	SetHeight(int)
 }
 
 // BasePaneModel provides common functionality for all panes
@@ -87,7 +102,18 @@ type BasePaneModel struct {
 	showLineNumbers bool
 	maxDisplayItems int
 	filter          string
+	filterMode      bool
+	filteredItems   []PaneItem
 	scrollOffset    int
+	width           int
+	height          int
+	spinner         Spinner
+	wrapNavigation  bool
+	sortOrder       SortOrder
+	keyBindings     []KeyBinding
+
+	// listTop is the View row the first visible item is drawn on
+	listTop int
 }
 
 // NewBasePaneModel creates a new base pane model
@@ -102,6 +128,8 @@ func NewBasePaneModel(title string, paneType PaneType, id string) BasePaneModel
 		loading:         false,
 		showLineNumbers: false,
 		maxDisplayItems: 50,
+		spinner:         NewSpinner(),
+		wrapNavigation:  true,
 	}
 }
 
@@ -122,10 +150,11 @@ func (b *BasePaneModel) GetID() string {
 
 // GetSelectedItem returns the currently selected item
 func (b *BasePaneModel) GetSelectedItem() *PaneItem {
-	if len(b.items) == 0 || b.selectedIndex >= len(b.items) || b.selectedIndex < 0 {
+	list := b.list()
+	if len(list) == 0 || b.selectedIndex >= len(list) || b.selectedIndex < 0 {
 		return nil
 	}
-	return &b.items[b.selectedIndex]
+	return &list[b.selectedIndex]
 }
 
 // GetItems returns all items
@@ -133,37 +162,44 @@ func (b *BasePaneModel) GetItems() []PaneItem {
 	return b.items
 }
 
-// GetItemCount returns the number of items
+// GetItemCount returns the number of items, after filtering
 func (b *BasePaneModel) GetItemCount() int {
-	return len(b.items)
+	return len(b.list())
 }
 
 // MoveUp moves selection up
 func (b *BasePaneModel) MoveUp() {
-	if len(b.items) == 0 {
+	count := b.GetItemCount()
+	if count == 0 {
 		return
 	}
 	if b.selectedIndex > 0 {
 		b.selectedIndex--
-	} else {
-		b.selectedIndex = len(b.items) - 1
+	} else if b.wrapNavigation {
+		b.selectedIndex = count - 1
 	}
 	b.adjustScrollOffset()
 }
 
 // MoveDown moves selection down
 func (b *BasePaneModel) MoveDown() {
-	if len(b.items) == 0 {
+	count := b.GetItemCount()
+	if count == 0 {
 		return
 	}
-	if b.selectedIndex < len(b.items)-1 {
+	if b.selectedIndex < count-1 {
 		b.selectedIndex++
-	} else {
+	} else if b.wrapNavigation {
 		b.selectedIndex = 0
 	}
 	b.adjustScrollOffset()
 }
 
+// SetWrapNavigation sets whether MoveUp/MoveDown wrap around at the ends
+func (b *BasePaneModel) SetWrapNavigation(wrap bool) {
+	b.wrapNavigation = wrap
+}
+
 // MoveToTop moves selection to the first item
 func (b *BasePaneModel) MoveToTop() {
 	b.selectedIndex = 0
@@ -172,20 +208,33 @@ func (b *BasePaneModel) MoveToTop() {
 
 // MoveToBottom moves selection to the last item
 func (b *BasePaneModel) MoveToBottom() {
-	if len(b.items) > 0 {
-		b.selectedIndex = len(b.items) - 1
+	if count := b.GetItemCount(); count > 0 {
+		b.selectedIndex = count - 1
 		b.adjustScrollOffset()
 	}
 }
 
 // SelectItem selects an item by index
 func (b *BasePaneModel) SelectItem(index int) {
-	if index >= 0 && index < len(b.items) {
+	if index >= 0 && index < b.GetItemCount() {
 		b.selectedIndex = index
 		b.adjustScrollOffset()
 	}
 }
 
+// ItemAt returns the index of the item drawn on the given row of the
+// pane's View, or -1 if no item is there
+func (b *BasePaneModel) ItemAt(row int) int {
+	if b.showLoading() {
+		return -1
+	}
+	offset := row - b.listTop
+	if offset < 0 || offset >= len(b.GetVisibleItems()) {
+		return -1
+	}
+	return b.scrollOffset + offset
+}
+
 // IsActive returns whether the pane is active
 func (b *BasePaneModel) IsActive() bool {
 	return b.active
@@ -201,6 +250,13 @@ func (b *BasePaneModel) IsLoading() bool {
 	return b.loading
 }
 
+// showLoading reports whether the loading view replaces the list. Only the
+// first load does; later refreshes keep the rows on screen and clickable
+// and only show the spinner in the pane title.
+func (b *BasePaneModel) showLoading() bool {
+	return b.loading && len(b.items) == 0
+}
+
 // SetLoading sets the loading state
 func (b *BasePaneModel) SetLoading(loading bool) {
 	b.loading = loading
@@ -209,6 +265,7 @@ func (b *BasePaneModel) SetLoading(loading bool) {
 // Clear clears all items
 func (b *BasePaneModel) Clear() {
 	b.items = []PaneItem{}
+	b.filteredItems = nil
 	b.selectedIndex = 0
 	b.scrollOffset = 0
 }
@@ -216,16 +273,73 @@ func (b *BasePaneModel) Clear() {
 // AddItem adds an item to the pane
 func (b *BasePaneModel) AddItem(item PaneItem) {
 	b.items = append(b.items, item)
+	if b.filterMode && matchesFilter(item, b.filter) {
+		b.filteredItems = append(b.filteredItems, item)
+	}
+}
+
+// InsertItems inserts items after index after; -1 inserts at the start.
+// The selection stays on the same item when it lies past the insertion point.
+func (b *BasePaneModel) InsertItems(after int, items []PaneItem) {
+	if after < -1 || after >= len(b.items) || len(items) == 0 {
+		return
+	}
+	selected := b.selectedValue()
+
+	at := after + 1
+	merged := make([]PaneItem, 0, len(b.items)+len(items))
+	merged = append(merged, b.items[:at]...)
+	merged = append(merged, items...)
+	merged = append(merged, b.items[at:]...)
+	b.items = merged
+
+	if b.selectedIndex >= at {
+		b.selectedIndex += len(items)
+	}
+	b.adjustScrollOffset()
+	b.refilter(selected)
+}
+
+// RemoveRange removes count items starting at index from
+func (b *BasePaneModel) RemoveRange(from, count int) {
+	if from < 0 || from >= len(b.items) || count <= 0 {
+		return
+	}
+	selected := b.selectedValue()
+	end := from + count
+	if end > len(b.items) {
+		end = len(b.items)
+	}
+
+	b.items = append(b.items[:from], b.items[end:]...)
+
+	// Keep the selection on the same item, or on the item that took the
+	// place of a removed selection
+	if b.selectedIndex >= end {
+		b.selectedIndex -= end - from
+	} else if b.selectedIndex >= from {
+		b.selectedIndex = from
+	}
+	if b.selectedIndex >= len(b.items) {
+		b.selectedIndex = len(b.items) - 1
+	}
+	if b.selectedIndex < 0 {
+		b.selectedIndex = 0
+	}
+	b.adjustScrollOffset()
+	b.refilter(selected)
 }
 
 // RemoveItem removes an item by index
 func (b *BasePaneModel) RemoveItem(index int) {
 	if index >= 0 && index < len(b.items) {
+		selected := b.selectedValue()
 		b.items = append(b.items[:index], b.items[index+1:]...)
 		if b.selectedIndex >= len(b.items) && len(b.items) > 0 {
 			b.selectedIndex = len(b.items) - 1
 		}
 		b.adjustScrollOffset()
+		b.refilter(selected)
 	}
 }
 
@@ -237,14 +351,86 @@ func (b *BasePaneModel) Filter(query string) []PaneItem {
 
 	var filtered []PaneItem
 	for _, item := range b.items {
-		// Simple case-insensitive substring match
-		if containsIgnoreCase(item.Display, query) || containsIgnoreCase(item.Value, query) {
+		if matchesFilter(item, query) {
 			filtered = append(filtered, item)
 		}
 	}
 	return filtered
 }
 
+// SetFilter narrows navigation and display to items matching query; an
+// empty query clears the filter
+func (b *BasePaneModel) SetFilter(query string) {
+	if query == "" {
+		b.ClearFilter()
+		return
+	}
+	selected := b.selectedValue()
+	b.filter = query
+	b.filterMode = true
+	b.refilter(selected)
+}
+
+// ClearFilter restores the full item list, keeping the selected item
+func (b *BasePaneModel) ClearFilter() {
+	if !b.filterMode {
+		return
+	}
+	selected := b.selectedValue()
+	b.filter = ""
+	b.filterMode = false
+	b.filteredItems = nil
+	if !b.JumpTo(selected) {
+		b.MoveToTop()
+	}
+}
+
+// GetFilter returns the active filter query
+func (b *BasePaneModel) GetFilter() string {
+	return b.filter
+}
+
+// list returns the items navigation operates on
+func (b *BasePaneModel) list() []PaneItem {
+	if b.filterMode {
+		return b.filteredItems
+	}
+	return b.items
+}
+
+// refilter recomputes the filtered items after the item list changes,
+// keeping the cursor on the previously selected item when it still matches
+func (b *BasePaneModel) refilter(selected string) {
+	if !b.filterMode {
+		return
+	}
+	b.filteredItems = b.Filter(b.filter)
+	if !b.JumpTo(selected) {
+		b.MoveToTop()
+	}
+}
+
+// restoreSelection reselects the item with value after a reload, or the
+// item now at index when that value is gone
+func (b *BasePaneModel) restoreSelection(value string, index int) {
+	if value != "" && b.JumpTo(value) {
+		return
+	}
+	if count := b.GetItemCount(); index >= count {
+		index = count - 1
+	}
+	if index >= 0 {
+		b.SelectItem(index)
+	}
+}
+
+func (b *BasePaneModel) selectedValue() string {
+	if item := b.GetSelectedItem(); item != nil {
+		return item.Value
+	}
+	return ""
+}
+
 // ShowLineNumbers returns whether to show line numbers
 func (b *BasePaneModel) ShowLineNumbers() bool {
 	return b.showLineNumbers
@@ -265,27 +451,95 @@ func (b *BasePaneModel) SetMaxDisplayItems(max int) {
 	b.maxDisplayItems = max
 }
 
+// SetWidth sets the content width available to the pane
+func (b *BasePaneModel) SetWidth(w int) {
+	b.width = w
+}
+
+// SetHeight sets the content height available to the pane
+func (b *BasePaneModel) SetHeight(h int) {
+	b.height = h
+}
+
+// HelpTextHeight is the rows an active pane spends on its help text: a
+// blank line and the key hints
+const HelpTextHeight = 2
+
+// clampDisplayItems sizes maxDisplayItems to the pane height, leaving
+// reserved lines for the title, indicators and footer
+func (b *BasePaneModel) clampDisplayItems(reserved int) {
+	if b.height <= 0 {
+		return
+	}
+	maxItems := b.height - reserved
+	if maxItems < 1 {
+		maxItems = 1
+	}
+	if maxItems != b.maxDisplayItems {
+		b.maxDisplayItems = maxItems
+		b.adjustScrollOffset()
+	}
+}
+
+// truncateToWidth clips a rendered line to the pane width
+func (b *BasePaneModel) truncateToWidth(line string) string {
+	if b.width <= 0 {
+		return line
+	}
+	return lipgloss.NewStyle().MaxWidth(b.width).Render(line)
+}
+
+// RenderWithScrollbar joins the visible item rows and draws a one-column
+// scrollbar to their right. Rows are clipped to leave room for the bar so
+// none of them wraps; they are joined as-is when every item fits.
+func (b *BasePaneModel) RenderWithScrollbar(rows []string, width int) string {
+	count := b.GetItemCount()
+	height := len(rows)
+	if count <= b.maxDisplayItems || width < 2 || height < 1 {
+		return lipgloss.JoinVertical(lipgloss.Left, rows...)
+	}
+
+	thumbHeight := b.maxDisplayItems * height / count
+	if thumbHeight < 1 {
+		thumbHeight = 1
+	}
+	thumbTop := b.scrollOffset * (height - thumbHeight) / (count - b.maxDisplayItems)
+
+	lines := make([]string, height)
+	for i, row := range rows {
+		row = ansi.Truncate(row, width-1, "")
+		row += strings.Repeat(" ", width-1-ansi.StringWidth(row))
+		if i >= thumbTop && i < thumbTop+thumbHeight {
+			lines[i] = row + "█"
+		} else {
+			lines[i] = row + "░"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // GetVisibleItems returns the items that should be visible based on scroll offset
 func (b *BasePaneModel) GetVisibleItems() []PaneItem {
-	if len(b.items) == 0 {
+	list := b.list()
+	if len(list) == 0 {
 		return []PaneItem{}
 	}
 
 	start := b.scrollOffset
 	end := start + b.maxDisplayItems
-	if end > len(b.items) {
-		end = len(b.items)
+	if end > len(list) {
+		end = len(list)
 	}
 	if start > end {
 		start = end
 	}
 
-	return b.items[start:end]
+	return list[start:end]
 }
 
 // adjustScrollOffset adjusts the scroll offset to keep selected item visible
 func (b *BasePaneModel) adjustScrollOffset() {
-	if len(b.items) == 0 {
+	if b.GetItemCount() == 0 {
 		b.scrollOffset = 0
 		return
 	}
@@ -316,6 +570,59 @@ func (b *BasePaneModel) GetScrollOffset() int {
 	return b.scrollOffset
 }
 
+// ExportText returns the listed items, one per line, for copying out
+func (b *BasePaneModel) ExportText() string {
+	lines := make([]string, 0, len(b.list()))
+	for _, item := range b.list() {
+		lines = append(lines, item.Display)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AddKeyBinding registers a key the pane handles so it shows up in help
+func (b *BasePaneModel) AddKeyBinding(key, desc, ctx string) {
+	b.keyBindings = append(b.keyBindings, KeyBinding{Key: key, Description: desc, Context: ctx})
+}
+
+// AddActionBinding registers a key that triggers a pane action; the action
+// is also offered by GetAvailableActions, labelled with desc
+func (b *BasePaneModel) AddActionBinding(key, action, desc, ctx string) {
+	b.keyBindings = append(b.keyBindings, KeyBinding{Key: key, Description: desc, Context: ctx, Action: action})
+}
+
+// GetKeyBindings returns the bindings registered by the pane constructor
+func (b *BasePaneModel) GetKeyBindings() []KeyBinding {
+	return b.keyBindings
+}
+
+// GetAvailableActions returns the actions bound to keys, in binding order
+func (b *BasePaneModel) GetAvailableActions() []string {
+	var actions []string
+	for _, binding := range b.keyBindings {
+		if binding.Action != "" {
+			actions = append(actions, binding.Action)
+		}
+	}
+	return actions
+}
+
+// GetActionLabels maps each bound action to its binding description
+func (b *BasePaneModel) GetActionLabels() map[string]string {
+	labels := make(map[string]string)
+	for _, binding := range b.keyBindings {
+		if binding.Action != "" {
+			labels[binding.Action] = binding.Description
+		}
+	}
+	return labels
+}
+
+// matchesFilter reports whether an item matches a filter query
+func matchesFilter(item PaneItem, query string) bool {
+	// Simple case-insensitive substring match
+	return containsIgnoreCase(item.Display, query) || containsIgnoreCase(item.Value, query)
+}
+
 // containsIgnoreCase performs case-insensitive substring matching
 func containsIgnoreCase(s, substr string) bool {
 	s = strings.ToLower(s)
@@ -0,0 +1,280 @@
+package panes
+
+import (
+	"strconv"
+	"testing"
+)
+
+// newTestPane returns a pane holding n items valued "0" to "n-1"
+func newTestPane(n int) *BasePaneModel {
+	b := NewBasePaneModel("Test", StatusPaneType, "test")
+	for i := 0; i < n; i++ {
+		b.AddItem(PaneItem{Display: "item " + strconv.Itoa(i), Value: strconv.Itoa(i)})
+	}
+	return &b
+}
+
+func TestWrapNavigation(t *testing.T) {
+	tests := []struct {
+		name  string
+		wrap  bool
+		start int
+		down  bool
+		want  int
+	}{
+		{"wrap down from last", true, 4, true, 0},
+		{"wrap up from first", true, 0, false, 4},
+		{"no wrap down from last", false, 4, true, 4},
+		{"no wrap up from first", false, 0, false, 0},
+		{"wrap down in middle", true, 2, true, 3},
+		{"no wrap up in middle", false, 2, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestPane(5)
+			b.SetWrapNavigation(tt.wrap)
+			b.SelectItem(tt.start)
+			if tt.down {
+				b.MoveDown()
+			} else {
+				b.MoveUp()
+			}
+			if got := b.GetSelectedIndex(); got != tt.want {
+				t.Errorf("selected %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapNavigationEmptyList(t *testing.T) {
+	b := newTestPane(0)
+	b.MoveDown()
+	b.MoveUp()
+	if got := b.GetSelectedIndex(); got != 0 {
+		t.Errorf("selected %d in an empty list, want 0", got)
+	}
+}
+
+// values returns the values of the listed items in order
+func values(b *BasePaneModel) []string {
+	var vals []string
+	for _, item := range b.list() {
+		vals = append(vals, item.Value)
+	}
+	return vals
+}
+
+func equalValues(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newItems(vals ...string) []PaneItem {
+	items := make([]PaneItem, len(vals))
+	for i, v := range vals {
+		items[i] = PaneItem{Display: "item " + v, Value: v}
+	}
+	return items
+}
+
+func TestInsertItems(t *testing.T) {
+	b := newTestPane(10)
+	b.InsertItems(2, newItems("a", "b", "c"))
+
+	want := []string{"0", "1", "2", "a", "b", "c", "3", "4", "5", "6", "7", "8", "9"}
+	if got := values(b); !equalValues(got, want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+func TestInsertItemsSelection(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected int
+		after    int
+		want     string
+	}{
+		{"selection after insertion point shifts", 5, 2, "5"},
+		{"selection at insertion point stays", 2, 2, "2"},
+		{"selection before insertion point stays", 1, 2, "1"},
+		{"insert at start shifts selection", 0, -1, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestPane(10)
+			b.SelectItem(tt.selected)
+			b.InsertItems(tt.after, newItems("a", "b", "c"))
+			if got := b.GetSelectedItem().Value; got != tt.want {
+				t.Errorf("selected %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertItemsAtStart(t *testing.T) {
+	b := newTestPane(3)
+	b.InsertItems(-1, newItems("a", "b"))
+
+	want := []string{"a", "b", "0", "1", "2"}
+	if got := values(b); !equalValues(got, want) {
+		t.Errorf("items = %v, want %v", got, want)
+	}
+}
+
+func TestInsertItemsOutOfRange(t *testing.T) {
+	b := newTestPane(3)
+	b.InsertItems(3, newItems("a"))
+	b.InsertItems(-2, newItems("a"))
+
+	if got := b.GetItemCount(); got != 3 {
+		t.Errorf("item count = %d, want 3", got)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		from, count int
+		selected    int
+		want        []string
+		wantValue   string
+	}{
+		{"middle", 2, 3, 7, []string{"0", "1", "5", "6", "7", "8", "9"}, "7"},
+		{"clamped at end", 8, 5, 3, []string{"0", "1", "2", "3", "4", "5", "6", "7"}, "3"},
+		{"removed selection moves to replacement", 2, 3, 3, []string{"0", "1", "5", "6", "7", "8", "9"}, "5"},
+		{"removed last selection moves to new last", 8, 5, 9, []string{"0", "1", "2", "3", "4", "5", "6", "7"}, "7"},
+		{"out of range is ignored", 10, 1, 4, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, "4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestPane(10)
+			b.SelectItem(tt.selected)
+			b.RemoveRange(tt.from, tt.count)
+			if got := values(b); !equalValues(got, tt.want) {
+				t.Errorf("items = %v, want %v", got, tt.want)
+			}
+			if got := b.GetSelectedItem().Value; got != tt.wantValue {
+				t.Errorf("selected %q, want %q", got, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestRemoveRangeEverything(t *testing.T) {
+	b := newTestPane(3)
+	b.SelectItem(2)
+	b.RemoveRange(0, 3)
+
+	if b.GetItemCount() != 0 || b.GetSelectedIndex() != 0 || b.GetSelectedItem() != nil {
+		t.Errorf("count %d, selected %d after removing everything", b.GetItemCount(), b.GetSelectedIndex())
+	}
+}
+
+func TestFilterNavigation(t *testing.T) {
+	b := newTestPane(20)
+	b.SetMaxDisplayItems(3)
+	b.SetFilter("1") // matches 1 and 10 to 19
+
+	if got := b.GetItemCount(); got != 11 {
+		t.Fatalf("filtered count = %d, want 11", got)
+	}
+	if got := b.GetSelectedItem().Value; got != "1" {
+		t.Errorf("selected %q after filtering, want first match %q", got, "1")
+	}
+
+	for i := 0; i < 4; i++ {
+		b.MoveDown()
+	}
+	if got := b.GetSelectedItem().Value; got != "13" {
+		t.Errorf("selected %q after moving down, want %q", got, "13")
+	}
+	if got := b.GetScrollOffset(); got != 2 {
+		t.Errorf("scroll offset = %d, want 2", got)
+	}
+	visible := []string{"11", "12", "13"}
+	var gotVisible []string
+	for _, item := range b.GetVisibleItems() {
+		gotVisible = append(gotVisible, item.Value)
+	}
+	if !equalValues(gotVisible, visible) {
+		t.Errorf("visible = %v, want %v", gotVisible, visible)
+	}
+
+	b.MoveToBottom()
+	if got := b.GetSelectedItem().Value; got != "19" {
+		t.Errorf("selected %q at bottom, want %q", got, "19")
+	}
+	if got := b.GetScrollOffset(); got != 8 {
+		t.Errorf("scroll offset at bottom = %d, want 8", got)
+	}
+
+	b.MoveDown()
+	if got := b.GetSelectedItem().Value; got != "1" {
+		t.Errorf("selected %q after wrapping, want %q", got, "1")
+	}
+	if got := b.GetScrollOffset(); got != 0 {
+		t.Errorf("scroll offset after wrapping = %d, want 0", got)
+	}
+}
+
+func TestFilterAddItemAndClear(t *testing.T) {
+	b := newTestPane(20)
+	b.SetFilter("1")
+
+	b.AddItem(PaneItem{Display: "item 21", Value: "21"})
+	b.AddItem(PaneItem{Display: "item 22", Value: "22"})
+	if got := b.GetItemCount(); got != 12 {
+		t.Errorf("filtered count after adding = %d, want 12", got)
+	}
+	if got := len(b.GetItems()); got != 22 {
+		t.Errorf("total count after adding = %d, want 22", got)
+	}
+
+	b.Clear()
+	if got := b.GetItemCount(); got != 0 {
+		t.Errorf("count after Clear = %d, want 0", got)
+	}
+	if b.GetSelectedItem() != nil {
+		t.Error("selected item after Clear should be nil")
+	}
+
+	b.AddItem(PaneItem{Display: "item 31", Value: "31"})
+	b.AddItem(PaneItem{Display: "item 32", Value: "32"})
+	if got := values(b); !equalValues(got, []string{"31"}) {
+		t.Errorf("filtered items after reload = %v, want [31]", got)
+	}
+	if got := b.GetFilter(); got != "1" {
+		t.Errorf("filter after Clear = %q, want it kept", got)
+	}
+}
+
+func TestClearFilterKeepsSelection(t *testing.T) {
+	b := newTestPane(20)
+	b.SetMaxDisplayItems(5)
+	b.SetFilter("1")
+	b.JumpTo("15")
+
+	b.ClearFilter()
+	if got := b.GetItemCount(); got != 20 {
+		t.Errorf("count after ClearFilter = %d, want 20", got)
+	}
+	if got := b.GetSelectedItem().Value; got != "15" {
+		t.Errorf("selected %q after ClearFilter, want %q", got, "15")
+	}
+	if got := b.GetSelectedIndex(); got != 15 {
+		t.Errorf("selected index after ClearFilter = %d, want 15", got)
+	}
+	if offset := b.GetScrollOffset(); offset > 15 || offset+5 <= 15 {
+		t.Errorf("scroll offset %d leaves index 15 out of view", offset)
+	}
+}
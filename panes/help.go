@@ -0,0 +1,120 @@
+package panes
+
+import (
+	"strings"
+	"tui101/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// KeyBinding describes a key and what it does in a given context
+type KeyBinding struct {
+	Key         string
+	Description string
+	Context     string
+	Action      string // pane action the key triggers, if any
+}
+
+// KeybindingHelp is an overlay listing key bindings grouped by context
+type KeybindingHelp struct {
+	lines     []string
+	scrollPos int
+	maxLines  int
+	st        *styles.Styles
+}
+
+// NewKeybindingHelp creates a cheat sheet for the given bindings, showing
+// at most maxLines rows at a time
+func NewKeybindingHelp(bindings []KeyBinding, maxLines int) *KeybindingHelp {
+	h := &KeybindingHelp{
+		maxLines: maxLines,
+		st:       styles.NewStyles(),
+	}
+	h.lines = h.formatBindings(bindings)
+	return h
+}
+
+func (h *KeybindingHelp) Init() tea.Cmd {
+	return nil
+}
+
+func (h *KeybindingHelp) Update(msg tea.Msg) (Overlay, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return h, nil
+	}
+
+	switch keyMsg.String() {
+	case "?", "q", "esc":
+		return h, Escape
+	case "j", "down":
+		if h.scrollPos < len(h.lines)-h.visibleLines() {
+			h.scrollPos++
+		}
+	case "k", "up":
+		if h.scrollPos > 0 {
+			h.scrollPos--
+		}
+	}
+
+	return h, nil
+}
+
+func (h *KeybindingHelp) View() string {
+	end := h.scrollPos + h.visibleLines()
+	if end > len(h.lines) {
+		end = len(h.lines)
+	}
+
+	var content []string
+	content = append(content, h.st.ActiveTitle.Render("Keyboard Shortcuts"))
+	content = append(content, "")
+	content = append(content, h.lines[h.scrollPos:end]...)
+	content = append(content, "")
+	content = append(content, h.st.Dimmed.Render("j/k: Scroll  ?/q: Close"))
+
+	return h.st.ActiveBorder.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
+
+// visibleLines returns how many binding rows fit, leaving room for the
+// title, footer and border
+func (h *KeybindingHelp) visibleLines() int {
+	visible := h.maxLines - 6
+	if visible < 1 {
+		visible = 1
+	}
+	return visible
+}
+
+// formatBindings renders bindings as a two-column table with a header
+// per context, in order of first appearance
+func (h *KeybindingHelp) formatBindings(bindings []KeyBinding) []string {
+	var contexts []string
+	grouped := make(map[string][]KeyBinding)
+	keyWidth := 0
+
+	for _, binding := range bindings {
+		if _, ok := grouped[binding.Context]; !ok {
+			contexts = append(contexts, binding.Context)
+		}
+		grouped[binding.Context] = append(grouped[binding.Context], binding)
+		if lipgloss.Width(binding.Key) > keyWidth {
+			keyWidth = lipgloss.Width(binding.Key)
+		}
+	}
+
+	var lines []string
+	for i, context := range contexts {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, h.st.WorkspaceName.Render(context))
+		for _, binding := range grouped[context] {
+			padding := strings.Repeat(" ", keyWidth-lipgloss.Width(binding.Key))
+			lines = append(lines, "  "+h.st.Highlight.Render(binding.Key)+padding+"  "+binding.Description)
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,21 @@
+package panes
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Overlay is a modal component rendered on top of the main layout.
+// While an overlay is open it receives all key input.
+type Overlay interface {
+	Init() tea.Cmd
+	Update(tea.Msg) (Overlay, tea.Cmd)
+	View() string
+}
+
+// EscapeMsg is emitted by an overlay to close itself
+type EscapeMsg struct{}
+
+// Escape returns a command that closes the top overlay
+func Escape() tea.Msg {
+	return EscapeMsg{}
+}
@@ -80,6 +80,7 @@ func (p *PackagesPane) loadPackages() {
 			Metadata: pkg,
 		})
 	}
+	p.applySort()
 }
 
 func NewBranchesPane() *PackagesPane {
@@ -91,6 +92,14 @@ func NewBranchesPane() *PackagesPane {
 		st:            styles.NewStyles(),
 	}
 
+	title := pane.GetTitle()
+	pane.AddKeyBinding("j/down", "Move down", title)
+	pane.AddKeyBinding("k/up", "Move up", title)
+	pane.AddKeyBinding("g", "Jump to first package", title)
+	pane.AddKeyBinding("G", "Jump to last package", title)
+	pane.AddKeyBinding("s", "Cycle sort order", title)
+	pane.AddActionBinding("r", "refresh", "Refresh packages", title)
+
 	pane.loadPackages()
 	return pane
 }
@@ -115,6 +124,8 @@ func (p *PackagesPane) Update(msg tea.Msg) (Pane, tea.Cmd) {
 			p.MoveToTop()
 		case "G":
 			p.MoveToBottom()
+		case "s":
+			p.CycleSort(p.GetSupportedSortOrders())
 		case "r":
 			return p, p.Refresh()
 		}
@@ -122,49 +133,67 @@ func (p *PackagesPane) Update(msg tea.Msg) (Pane, tea.Cmd) {
 	case PackagesUpdateMsg:
 		p.updateFromPackagesMsg(msg)
 		return p, nil
+
+	case SpinnerTickMsg:
+		return p, p.UpdateSpinner(msg)
 	}
 
 	return p, nil
 }
 
 func (p *PackagesPane) View() string {
-	if p.IsLoading() {
-		return p.st.LoadingText.Render("Loading packages...")
+	if p.showLoading() {
+		return p.st.LoadingText.Render(p.SpinnerView() + " Loading packages...")
 	}
 
 	if len(p.items) == 0 {
 		return p.st.InfoText.Render("No packages found")
 	}
 
+	// The footer and its padding take 2 lines, help text 2 more
+	reserved := 2
+	if p.IsActive() {
+		reserved += HelpTextHeight
+	}
+	p.clampDisplayItems(reserved)
+
 	var lines []string
 	visibleItems := p.GetVisibleItems()
 
-	if p.GetScrollOffset() > 0 {
-		lines = append(lines, p.st.RenderScrollIndicator("up"))
-	}
-
+	var itemLines []string
 	for i, item := range visibleItems {
 		actualIndex := p.GetScrollOffset() + i
 		isSelected := actualIndex == p.GetSelectedIndex()
 
 		line := p.formatPackageItem(item, isSelected)
-		lines = append(lines, line)
+		itemLines = append(itemLines, p.truncateToWidth(line))
+	}
+	if len(itemLines) > 0 {
+		lines = append(lines, p.RenderWithScrollbar(itemLines, p.width))
 	}
 
-	if p.GetScrollOffset()+len(visibleItems) < len(p.items) {
-		lines = append(lines, p.st.RenderScrollIndicator("down"))
+	if p.GetItemCount() == 0 {
+		lines = append(lines, p.truncateToWidth(p.st.InfoText.Render("  No packages match the filter")))
 	}
 
+	// The footer style pads its own top, spacing it from the list
 	if len(p.items) > 0 {
-		lines = append(lines, "")
-		footer := p.st.RenderFooter("Packages", p.GetSelectedIndex()+1, len(p.items))
-		lines = append(lines, footer)
+		label := fmt.Sprintf("Packages (by %s)", p.GetSort())
+		if p.GetFilter() != "" {
+			label = fmt.Sprintf("Packages (by %s, /%s)", p.GetSort(), p.GetFilter())
+		}
+		current := p.GetSelectedIndex() + 1
+		if p.GetItemCount() == 0 {
+			current = 0
+		}
+		footer := p.st.RenderFooter(label, current, p.GetItemCount())
+		lines = append(lines, p.truncateToWidth(footer))
 	}
 
 	// Add help text if active
 	if p.IsActive() {
 		lines = append(lines, "")
-		lines = append(lines, p.st.Dimmed.Render("j/k: Navigate  g/G: Top/Bottom  r: Refresh"))
+		lines = append(lines, p.truncateToWidth(p.st.Dimmed.Render("j/k g/G: Navigate  s: Sort  r: Refresh")))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -193,10 +222,10 @@ func (p *PackagesPane) formatPackageItem(item PaneItem, isSelected bool) string
 
 func (p *PackagesPane) Refresh() tea.Cmd {
 	p.SetLoading(true)
-	return func() tea.Msg {
+	return tea.Batch(p.StartSpinner(), func() tea.Msg {
 		packages := p.gatherPackages()
 		return PackagesUpdateMsg{Packages: packages}
-	}
+	})
 }
 
 func (p *PackagesPane) HandleAction(action string) tea.Cmd {
@@ -207,8 +236,8 @@ func (p *PackagesPane) HandleAction(action string) tea.Cmd {
 	return nil
 }
 
-func (p *PackagesPane) GetAvailableActions() []string {
-	return []string{"refresh"}
+func (p *PackagesPane) GetSupportedSortOrders() []SortOrder {
+	return []SortOrder{SortByName, SortByStatus}
 }
 
 func (p *PackagesPane) gatherPackages() []Package {
@@ -251,6 +280,8 @@ func (p *PackagesPane) gatherPackages() []Package {
 
 func (p *PackagesPane) updateFromPackagesMsg(msg PackagesUpdateMsg) {
 	p.SetLoading(false)
+	p.StopSpinner()
+	selected, index := p.selectedValue(), p.GetSelectedIndex()
 	p.Clear()
 	p.packages = msg.Packages
 
@@ -263,6 +294,8 @@ func (p *PackagesPane) updateFromPackagesMsg(msg PackagesUpdateMsg) {
 			Metadata: pkg,
 		})
 	}
+	p.applySort()
+	p.restoreSelection(selected, index)
 }
 
 func (p *PackagesPane) formatPackageDisplay(pkg Package) string {
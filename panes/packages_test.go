@@ -0,0 +1,40 @@
+package panes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestScrollbarRowsDoNotWrap(t *testing.T) {
+	p := NewBranchesPane()
+	var packages []Package
+	for i := 0; i < 30; i++ {
+		name := fmt.Sprintf("p%02d-%s", i, strings.Repeat("x", 50))
+		packages = append(packages, Package{Name: name, Status: "active", Branch: "main"})
+	}
+	p.updateFromPackagesMsg(PackagesUpdateMsg{Packages: packages})
+	p.SetActive(true)
+	p.SetWidth(47)
+	p.SetHeight(12)
+	p.SelectItem(13)
+
+	lines := strings.Split(p.View(), "\n")
+	visible := p.GetVisibleItems()
+	if len(visible) == 0 || len(visible) == p.GetItemCount() {
+		t.Fatalf("%d of %d items visible, want a scrolled list", len(visible), p.GetItemCount())
+	}
+	if len(lines) < len(visible)+1 || strings.TrimSpace(lines[len(visible)]) != "" {
+		t.Fatalf("list is not %d rows followed by the footer:\n%s", len(visible), strings.Join(lines, "\n"))
+	}
+
+	list := strings.Join(lines[:len(visible)], "\n")
+	if !strings.Contains(list, "❯") {
+		t.Errorf("selected row not shown:\n%s", list)
+	}
+	for i, item := range visible {
+		if !strings.Contains(lines[i], item.Value[:4]) {
+			t.Errorf("row %d is %q, want item %s", i, lines[i], item.Value[:4])
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package panes
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortOrder identifies how a pane orders its items
+type SortOrder int
+
+const (
+	SortByName SortOrder = iota
+	SortByDate
+	SortByStatus
+	SortBySize
+)
+
+func (o SortOrder) String() string {
+	switch o {
+	case SortByName:
+		return "name"
+	case SortByDate:
+		return "date"
+	case SortByStatus:
+		return "status"
+	case SortBySize:
+		return "size"
+	default:
+		return "unknown"
+	}
+}
+
+// datedItem is implemented by item metadata that can be sorted by date
+type datedItem interface {
+	SortDate() time.Time
+}
+
+// sizedItem is implemented by item metadata that can be sorted by size
+type sizedItem interface {
+	SortSize() int64
+}
+
+// SetSort sorts the items by order, keeping the selection on the same item
+func (b *BasePaneModel) SetSort(order SortOrder) {
+	b.sortOrder = order
+	b.applySort()
+}
+
+// GetSort returns the active sort order
+func (b *BasePaneModel) GetSort() SortOrder {
+	return b.sortOrder
+}
+
+// CycleSort switches to the order after the active one in supported
+func (b *BasePaneModel) CycleSort(supported []SortOrder) {
+	if len(supported) == 0 {
+		return
+	}
+	next := supported[0]
+	for i, order := range supported {
+		if order == b.sortOrder {
+			next = supported[(i+1)%len(supported)]
+			break
+		}
+	}
+	b.SetSort(next)
+}
+
+// JumpTo selects the first visible item with the given value
+func (b *BasePaneModel) JumpTo(value string) bool {
+	for i, item := range b.list() {
+		if item.Value == value {
+			b.SelectItem(i)
+			return true
+		}
+	}
+	return false
+}
+
+// applySort re-sorts the items by the active order
+func (b *BasePaneModel) applySort() {
+	selected := b.selectedValue()
+
+	sort.SliceStable(b.items, func(i, j int) bool {
+		return b.lessItem(b.items[i], b.items[j])
+	})
+
+	if b.filterMode {
+		b.refilter(selected)
+	} else if selected != "" {
+		b.JumpTo(selected)
+	}
+}
+
+func (b *BasePaneModel) lessItem(a, c PaneItem) bool {
+	switch b.sortOrder {
+	case SortByDate:
+		ad, aok := a.Metadata.(datedItem)
+		cd, cok := c.Metadata.(datedItem)
+		if aok && cok {
+			// Newest first
+			return ad.SortDate().After(cd.SortDate())
+		}
+		return false
+	case SortByStatus:
+		if a.Type != c.Type {
+			return a.Type < c.Type
+		}
+	case SortBySize:
+		as, aok := a.Metadata.(sizedItem)
+		cs, cok := c.Metadata.(sizedItem)
+		if aok && cok {
+			// Largest first
+			return as.SortSize() > cs.SortSize()
+		}
+		return false
+	}
+	return strings.ToLower(a.Display) < strings.ToLower(c.Display)
+}
@@ -0,0 +1,78 @@
+package panes
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const spinnerInterval = 100 * time.Millisecond
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner cycles through animation frames while a pane is loading
+type Spinner struct {
+	frames   []string
+	current  int
+	spinning bool
+	tag      int
+}
+
+// SpinnerTickMsg advances the spinner of the pane with the given ID
+type SpinnerTickMsg struct {
+	PaneID string
+	tag    int
+}
+
+// NewSpinner creates a braille spinner
+func NewSpinner() Spinner {
+	return Spinner{frames: spinnerFrames}
+}
+
+// View returns the current frame
+func (s *Spinner) View() string {
+	return s.frames[s.current]
+}
+
+// StartSpinner starts the spinner and returns the first tick, or nil if
+// it is already running so only one tick chain exists at a time
+func (b *BasePaneModel) StartSpinner() tea.Cmd {
+	if b.spinner.spinning || !b.loading {
+		return nil
+	}
+	b.spinner.spinning = true
+	b.spinner.current = 0
+	b.spinner.tag++
+	return b.spinnerTick()
+}
+
+// StopSpinner stops the spinner; any pending tick is dropped on arrival
+func (b *BasePaneModel) StopSpinner() {
+	b.spinner.spinning = false
+}
+
+// UpdateSpinner advances the spinner on its own ticks and schedules the
+// next one while the pane is still loading
+func (b *BasePaneModel) UpdateSpinner(msg SpinnerTickMsg) tea.Cmd {
+	if msg.PaneID != b.id || msg.tag != b.spinner.tag || !b.spinner.spinning {
+		return nil
+	}
+	if !b.loading {
+		b.spinner.spinning = false
+		return nil
+	}
+	b.spinner.current = (b.spinner.current + 1) % len(b.spinner.frames)
+	return b.spinnerTick()
+}
+
+// SpinnerView returns the current spinner frame
+func (b *BasePaneModel) SpinnerView() string {
+	return b.spinner.View()
+}
+
+func (b *BasePaneModel) spinnerTick() tea.Cmd {
+	id, tag := b.id, b.spinner.tag
+	return tea.Tick(spinnerInterval, func(time.Time) tea.Msg {
+		return SpinnerTickMsg{PaneID: id, tag: tag}
+	})
+}
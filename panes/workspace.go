@@ -1,7 +1,11 @@
 package panes
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os/exec"
+	"strings"
 	"time"
 	"tui101/styles"
 
@@ -22,6 +26,15 @@ type WorkspaceInfo struct {
 	Name       string
 	VersionSet string
 	LastSync   time.Time
+	SSH        SSHStatus
+}
+
+// SSHStatus describes the keys loaded in the SSH agent
+type SSHStatus struct {
+	AgentRunning bool
+	Fingerprints []string
+	// Err is set when ssh-add could not report on the agent at all
+	Err error
 }
 
 func NewStatusPane() *StatusPane {
@@ -32,6 +45,11 @@ func NewStatusPane() *StatusPane {
 		st:            styles.NewStyles(),
 	}
 
+	title := pane.GetTitle()
+	pane.AddKeyBinding("j/down", "Move down", title)
+	pane.AddKeyBinding("k/up", "Move up", title)
+	pane.AddActionBinding("r", "refresh", "Refresh workspace", title)
+
 	pane.loadWorkspaceInfo()
 	return pane
 }
@@ -59,27 +77,39 @@ func (s *StatusPane) Update(msg tea.Msg) (Pane, tea.Cmd) {
 	case WorkspaceUpdateMsg:
 		s.updateFromWorkspaceInfo(msg)
 		return s, nil
+
+	case SpinnerTickMsg:
+		return s, s.UpdateSpinner(msg)
 	}
 
 	return s, nil
 }
 
 func (s *StatusPane) View() string {
-	if s.IsLoading() {
-		return s.st.LoadingText.Render("Loading workspace...")
+	if s.showLoading() {
+		return s.st.LoadingText.Render(s.SpinnerView() + " Loading workspace...")
 	}
 
 	if len(s.items) == 0 {
 		return s.st.InfoText.Render("No workspace information")
 	}
 
+	// Header, spacer and footer separator take 3 lines, help text 2 more
+	reserved := 3
+	if s.IsActive() {
+		reserved += HelpTextHeight
+	}
+	s.clampDisplayItems(reserved)
+
 	var lines []string
 
 	// Add a nice header
-	lines = append(lines, s.st.Dimmed.Render("━━━━━━━━━━━━━━━━━━━━━━━━"))
+	lines = append(lines, s.truncateToWidth(s.st.Dimmed.Render("━━━━━━━━━━━━━━━━━━━━━━━━")))
+	s.listTop = len(lines)
 
-	for i, item := range s.items {
-		isSelected := i == s.GetSelectedIndex()
+	var itemLines []string
+	for i, item := range s.GetVisibleItems() {
+		isSelected := s.GetScrollOffset()+i == s.GetSelectedIndex()
 
 		var line string
 		var style lipgloss.Style
@@ -92,6 +122,11 @@ func (s *StatusPane) View() string {
 			style = s.st.WorkspaceVersion
 		case "metadata":
 			style = s.st.WorkspaceMetadata
+		case "ssh":
+			style = s.st.WorkspaceMetadata
+			if ssh, ok := item.Metadata.(SSHStatus); ok && len(ssh.Fingerprints) == 0 {
+				style = s.st.WarningText
+			}
 		default:
 			style = s.st.UnselectedItem
 		}
@@ -126,17 +161,34 @@ func (s *StatusPane) View() string {
 			}
 		}
 
-		lines = append(lines, style.Render(line))
+		itemLines = append(itemLines, s.truncateToWidth(style.Render(line)))
+	}
+	if len(itemLines) > 0 {
+		lines = append(lines, s.RenderWithScrollbar(itemLines, s.width))
 	}
 
-	// Add a footer separator
-	lines = append(lines, "")
-	lines = append(lines, s.st.Dimmed.Render("━━━━━━━━━━━━━━━━━━━━━━━━"))
+	if s.GetItemCount() == 0 {
+		lines = append(lines, s.truncateToWidth(s.st.InfoText.Render("  No workspace rows match the filter")))
+	}
+
+	// Add a footer separator, or the filter footer while filtering; both
+	// take two lines
+	if s.GetFilter() != "" {
+		current := s.GetSelectedIndex() + 1
+		if s.GetItemCount() == 0 {
+			current = 0
+		}
+		label := fmt.Sprintf("Workspace (/%s)", s.GetFilter())
+		lines = append(lines, s.truncateToWidth(s.st.RenderFooter(label, current, s.GetItemCount())))
+	} else {
+		lines = append(lines, "")
+		lines = append(lines, s.truncateToWidth(s.st.Dimmed.Render("━━━━━━━━━━━━━━━━━━━━━━━━")))
+	}
 
 	// Add help text if active
 	if s.IsActive() {
 		lines = append(lines, "")
-		lines = append(lines, s.st.Dimmed.Render("↑↓: Navigate  r: Refresh"))
+		lines = append(lines, s.truncateToWidth(s.st.Dimmed.Render("↑↓: Navigate  r: Refresh")))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -144,12 +196,12 @@ func (s *StatusPane) View() string {
 
 func (s *StatusPane) Refresh() tea.Cmd {
 	s.SetLoading(true)
-	return func() tea.Msg {
+	return tea.Batch(s.StartSpinner(), func() tea.Msg {
 		// Simulate some loading time
 		time.Sleep(500 * time.Millisecond)
 		info := s.gatherWorkspaceInfo()
 		return WorkspaceUpdateMsg{Info: info}
-	}
+	})
 }
 
 func (s *StatusPane) HandleAction(action string) tea.Cmd {
@@ -160,8 +212,9 @@ func (s *StatusPane) HandleAction(action string) tea.Cmd {
 	return nil
 }
 
-func (s *StatusPane) GetAvailableActions() []string {
-	return []string{"refresh"}
+// GetSupportedSortOrders returns nil; workspace rows have a fixed order
+func (s *StatusPane) GetSupportedSortOrders() []SortOrder {
+	return nil
 }
 
 func (s *StatusPane) loadWorkspaceInfo() {
@@ -192,11 +245,64 @@ func (s *StatusPane) gatherWorkspaceInfo() WorkspaceInfo {
 		Name:       "JoeWorkspace",
 		VersionSet: "@a1b2c3d",
 		LastSync:   time.Now(),
+		SSH:        gatherSSHStatus(),
+	}
+}
+
+// sshAddTimeout bounds ssh-add so a hung agent cannot stall a refresh
+const sshAddTimeout = 2 * time.Second
+
+// gatherSSHStatus lists the agent's keys with ssh-add -l, which exits 1
+// when the agent has no keys and 2 when no agent is reachable
+func gatherSSHStatus() SSHStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), sshAddTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "ssh-add", "-l").Output()
+	if ctx.Err() != nil {
+		return SSHStatus{Err: errors.New("ssh-add timed out")}
+	}
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			switch exitErr.ExitCode() {
+			case 1:
+				return SSHStatus{AgentRunning: true}
+			case 2:
+				return SSHStatus{}
+			}
+		}
+		return SSHStatus{Err: err}
+	}
+
+	status := SSHStatus{AgentRunning: true}
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			status.Fingerprints = append(status.Fingerprints, line)
+		}
+	}
+	return status
+}
+
+func formatSSHStatus(ssh SSHStatus) string {
+	switch {
+	case ssh.Err != nil:
+		return "SSH: status unavailable"
+	case !ssh.AgentRunning:
+		return "SSH: no agent"
+	case len(ssh.Fingerprints) == 0:
+		return "SSH: no keys loaded"
+	case len(ssh.Fingerprints) == 1:
+		return "SSH: 1 key loaded"
+	default:
+		return fmt.Sprintf("SSH: %d keys loaded", len(ssh.Fingerprints))
 	}
 }
 
 func (s *StatusPane) updateFromWorkspaceInfo(msg WorkspaceUpdateMsg) {
 	s.SetLoading(false)
+	s.StopSpinner()
+	selected, index := s.selectedValue(), s.GetSelectedIndex()
 	s.Clear()
 
 	info := msg.Info
@@ -218,4 +324,15 @@ func (s *StatusPane) updateFromWorkspaceInfo(msg WorkspaceUpdateMsg) {
 		Value:   info.LastSync.Format(time.RFC3339),
 		Type:    "metadata",
 	})
+
+	s.AddItem(PaneItem{
+		Display: formatSSHStatus(info.SSH),
+		// Fingerprints live in Metadata; a fixed Value keeps the row from
+		// matching filter text it does not show
+		Value:    "ssh",
+		Type:     "ssh",
+		Metadata: info.SSH,
+	})
+
+	s.restoreSelection(selected, index)
 }
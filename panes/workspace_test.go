@@ -0,0 +1,76 @@
+package panes
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestStatusPane() *StatusPane {
+	s := NewStatusPane()
+	s.updateFromWorkspaceInfo(WorkspaceUpdateMsg{Info: WorkspaceInfo{
+		Name:       "JoeWorkspace",
+		VersionSet: "@a1b2c3d",
+		LastSync:   time.Now(),
+		SSH: SSHStatus{
+			AgentRunning: true,
+			Fingerprints: []string{"256 SHA256:abcdef joe@host (ED25519)"},
+		},
+	}})
+	s.SetActive(true)
+	s.SetWidth(47)
+	s.SetHeight(12)
+	return s
+}
+
+func TestWorkspaceFilterFooter(t *testing.T) {
+	s := newTestStatusPane()
+
+	s.SetFilter("version")
+	view := s.View()
+	if !strings.Contains(view, "Workspace (/version): 1/1") {
+		t.Errorf("filter footer missing:\n%s", view)
+	}
+
+	s.SetFilter("nothing")
+	view = s.View()
+	if !strings.Contains(view, "No workspace rows match the filter") {
+		t.Errorf("empty-match message missing:\n%s", view)
+	}
+	if !strings.Contains(view, "Workspace (/nothing): 0/0") {
+		t.Errorf("filter footer missing:\n%s", view)
+	}
+}
+
+func TestWorkspaceFilterIgnoresFingerprints(t *testing.T) {
+	s := newTestStatusPane()
+
+	s.SetFilter("SHA256")
+	if got := s.GetItemCount(); got != 0 {
+		t.Errorf("%d rows match hidden fingerprint text, want 0", got)
+	}
+	s.SetFilter("key loaded")
+	if got := s.GetItemCount(); got != 1 {
+		t.Errorf("%d rows match the SSH row's text, want 1", got)
+	}
+}
+
+func TestFormatSSHStatus(t *testing.T) {
+	tests := []struct {
+		ssh  SSHStatus
+		want string
+	}{
+		{SSHStatus{Err: errors.New("exec: \"ssh-add\": executable file not found")}, "SSH: status unavailable"},
+		{SSHStatus{}, "SSH: no agent"},
+		{SSHStatus{AgentRunning: true}, "SSH: no keys loaded"},
+		{SSHStatus{AgentRunning: true, Fingerprints: []string{"a"}}, "SSH: 1 key loaded"},
+		{SSHStatus{AgentRunning: true, Fingerprints: []string{"a", "b"}}, "SSH: 2 keys loaded"},
+	}
+
+	for _, tt := range tests {
+		if got := formatSSHStatus(tt.ssh); got != tt.want {
+			t.Errorf("formatSSHStatus(%+v) = %q, want %q", tt.ssh, got, tt.want)
+		}
+	}
+}
@@ -67,9 +67,6 @@ type Styles struct {
 	// Greeting styles
 	GreetingText lipgloss.Style
 
-	// Scrollbar indicators
-	ScrollIndicator lipgloss.Style
-
 	// Footer styles
 	Footer lipgloss.Style
 
@@ -187,11 +184,6 @@ func NewStyles() *Styles {
 			Bold(true).
 			Align(lipgloss.Center),
 
-		// Scrollbar indicators
-		ScrollIndicator: lipgloss.NewStyle().
-			Foreground(lipgloss.Color(DimGray)).
-			Italic(true),
-
 		// Footer styles
 		Footer: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(Blue)).
@@ -294,14 +286,6 @@ func (s *Styles) RenderCursor(isActive bool) string {
 	return "  "
 }
 
-// RenderScrollIndicator renders scroll indicators
-func (s *Styles) RenderScrollIndicator(direction string) string {
-	if direction == "up" {
-		return s.ScrollIndicator.Render("  ↑ more items above")
-	}
-	return s.ScrollIndicator.Render("  ↓ more items below")
-}
-
 // RenderFooter renders a footer with count information
 func (s *Styles) RenderFooter(label string, current, total int) string {
 	return s.Footer.Render(lipgloss.JoinHorizontal(